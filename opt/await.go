@@ -0,0 +1,86 @@
+package opt
+
+import "errors"
+
+// completed returns a channel that receives the index of each future as it is resolved.
+// Callers must close stop when they are done reading from the channel,
+// so the goroutines waiting for unresolved futures can exit.
+func completed[T any](futures []*Future[T], stop <-chan struct{}) <-chan int {
+	ch := make(chan int, len(futures))
+	for i, fut := range futures {
+		go func() {
+			select {
+			case <-fut.Done():
+				ch <- i
+			case <-stop:
+			}
+		}()
+	}
+	return ch
+}
+
+// AwaitAll blocks until all the futures are resolved and returns their values,
+// in the same order as the futures.
+// If any future resolves to an empty or error opt, AwaitAll returns that opt immediately,
+// without waiting for the remaining futures.
+func AwaitAll[T any](futures ...*Future[T]) Opt[[]T] {
+	vals := make([]T, len(futures))
+	stop := make(chan struct{})
+	defer close(stop)
+	ch := completed(futures, stop)
+	for range futures {
+		i := <-ch
+		val, err := futures[i].Await().Return()
+		if err != nil {
+			return ErrorOf[[]T](err)
+		}
+		vals[i] = val
+	}
+	return Of(vals)
+}
+
+// AwaitAny returns the first ok value from the futures, in completion order.
+// If none of the futures resolve to an ok opt, AwaitAny returns an error opt
+// with the errors of all the futures, joined via errors.Join in the same order as the futures.
+// If there are no futures, the result is an empty opt.
+func AwaitAny[T any](futures ...*Future[T]) Opt[T] {
+	if len(futures) == 0 {
+		return Empty[T]()
+	}
+
+	errs := make([]error, len(futures))
+	stop := make(chan struct{})
+	defer close(stop)
+	ch := completed(futures, stop)
+	for range futures {
+		i := <-ch
+		res := futures[i].Await()
+		if res.Ok() {
+			return res
+		}
+		errs[i] = res.Error()
+	}
+	return ErrorOf[T](errors.Join(errs...))
+}
+
+// Race returns the result of the first future to be resolved, whether it is ok or not.
+// If there are no futures, the result is an empty opt.
+func Race[T any](futures ...*Future[T]) Opt[T] {
+	if len(futures) == 0 {
+		return Empty[T]()
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	i := <-completed(futures, stop)
+	return futures[i].Await()
+}
+
+// AllSettled blocks until all the futures are resolved, and returns all the results,
+// in the same order as the futures.
+func AllSettled[T any](futures ...*Future[T]) []Opt[T] {
+	res := make([]Opt[T], len(futures))
+	for i, fut := range futures {
+		res[i] = fut.Await()
+	}
+	return res
+}
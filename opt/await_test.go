@@ -0,0 +1,86 @@
+package opt
+
+import (
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// gated returns a future that resolves to res when the gate channel is closed.
+func gated[T any](gate chan struct{}, res Opt[T]) *Future[T] {
+	return Promise(func(resolve func(Opt[T])) {
+		<-gate
+		resolve(res)
+	})
+}
+
+func resolved[T any](res Opt[T]) *Future[T] {
+	return gated(closedGate(), res)
+}
+
+func closedGate() chan struct{} {
+	gate := make(chan struct{})
+	close(gate)
+	return gate
+}
+
+func TestAwaitAll(t *testing.T) {
+	is(t, AwaitAll(resolved(Of(1)), resolved(Of(2)), resolved(Of(3))), []int{1, 2, 3})
+	is(t, AwaitAll[int](), []int{})
+
+	// Fails on the first error, without waiting for the others
+	never := make(chan struct{})
+	isError(t, AwaitAll(gated(never, Of(1)), resolved(ErrorOf[int](theError))), theError)
+	isError(t, AwaitAll(gated(never, Of(1)), resolved(Empty[int]())), ErrEmpty)
+}
+
+func TestAwaitAny(t *testing.T) {
+	never := make(chan struct{})
+	is(t, AwaitAny(gated(never, Of(1)), resolved(ErrorOf[int](theError)), resolved(Of(2))), 2)
+	isError(t, AwaitAny[int](), ErrEmpty)
+
+	res := AwaitAny(resolved(ErrorOf[int](theError)), resolved(ErrorOf[int](atTheDiscoError)))
+	if err := res.Error(); !errors.Is(err, theError) || !errors.Is(err, atTheDiscoError) {
+		t.Fatalf("expected both errors, got: %v", err)
+	}
+}
+
+func TestRace(t *testing.T) {
+	never := make(chan struct{})
+	is(t, Race(gated(never, Of(1)), resolved(Of(2))), 2)
+	isError(t, Race(gated(never, Of(1)), resolved(ErrorOf[int](theError))), theError)
+	isError(t, Race[int](), ErrEmpty)
+}
+
+func TestAwaitDoesNotLeak(t *testing.T) {
+	before := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		never := newFuture[int]() // never resolved, and has no goroutine of its own
+		Race(never, resolved(Of(1)))
+		AwaitAny(never, resolved(Of(1)))
+		AwaitAll(never, resolved(ErrorOf[int](theError)))
+	}
+
+	// The helper goroutines exit shortly after the calls return
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Fatalf("waiting for unresolved futures leaked goroutines: %d before, %d after", before, after)
+	}
+}
+
+func TestAllSettled(t *testing.T) {
+	gate := make(chan struct{})
+	futs := []*Future[int]{gated(gate, Of(1)), resolved(ErrorOf[int](theError)), resolved(Of(3))}
+	close(gate)
+
+	res := AllSettled(futs...)
+	expect := []Opt[int]{Of(1), ErrorOf[int](theError), Of(3)}
+	if !reflect.DeepEqual(res, expect) {
+		t.Fatalf("expected %v, got %v", expect, res)
+	}
+}
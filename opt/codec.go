@@ -0,0 +1,153 @@
+package opt
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrEncode is returned, wrapping the opt's own error, when trying to encode an opt holding
+// an error other than ErrEmpty. Errors are not data, and can not be stored or sent anywhere as such.
+// Use MapErr, OrElse, or similar to handle the error before encoding the opt.
+var ErrEncode = errors.New("cannot encode opt with error")
+
+var jsonNull = []byte("null")
+
+// encodeErr returns nil if the opt can be encoded, and otherwise an error wrapping ErrEncode.
+func (o Opt[T]) encodeErr() error {
+	if o.err == nil || o.err == ErrEmpty {
+		return nil
+	}
+	return fmt.Errorf("%w: %w", ErrEncode, o.err)
+}
+
+// IsZero returns true if the opt is empty. It is used by encoding/json, as of Go 1.24, to omit empty
+// opts from struct fields tagged with omitzero. Note that this is not the same as the opt holding the zero
+// value, like the opts created with Zero; those are ok, and not considered zero by IsZero.
+func (o Opt[T]) IsZero() bool {
+	return o.err == ErrEmpty
+}
+
+// MarshalJSON implements json.Marshaler.
+// Ok opts are encoded as their value, and empty opts as null.
+// To leave out empty opts entirely, tag the struct field with omitzero.
+// Opts holding any other error refuse to be encoded, and return an error wrapping ErrEncode.
+func (o Opt[T]) MarshalJSON() ([]byte, error) {
+	if err := o.encodeErr(); err != nil {
+		return nil, err
+	} else if o.err == ErrEmpty {
+		return jsonNull, nil
+	}
+	return json.Marshal(o.val)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null decodes to an empty opt.
+//
+// Note that absent is not the same as empty: encoding/json does not call UnmarshalJSON for fields
+// that are absent from the input, so such fields keep their previous value. For a new struct that is
+// the zero Opt, which is ok and holds the zero value of T. If absent fields should be empty,
+// initialize them with Empty before decoding.
+func (o *Opt[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		*o = Empty[T]()
+		return nil
+	}
+
+	var t T
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+	*o = Of(t)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. Empty opts are encoded as empty text.
+// If T implements encoding.TextMarshaler it is used for the value,
+// otherwise the value is formatted with fmt.Sprint.
+// Opts holding any other error than ErrEmpty return an error wrapping ErrEncode.
+func (o Opt[T]) MarshalText() ([]byte, error) {
+	if err := o.encodeErr(); err != nil {
+		return nil, err
+	} else if o.err == ErrEmpty {
+		return []byte{}, nil
+	}
+
+	switch v := any(o.val).(type) {
+	case encoding.TextMarshaler:
+		return v.MarshalText()
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	default:
+		return []byte(fmt.Sprint(v)), nil
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text decodes to an empty opt.
+// This means that an ok opt holding an empty string does not survive a round trip via text.
+// If *T implements encoding.TextUnmarshaler it is used for the value,
+// otherwise the text is parsed with fmt.Fscan, which works for basic types like numbers and bools.
+// The whole text must be a single value; any text left after it is an error.
+func (o *Opt[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = Empty[T]()
+		return nil
+	}
+
+	var t T
+	switch p := any(&t).(type) {
+	case encoding.TextUnmarshaler:
+		if err := p.UnmarshalText(text); err != nil {
+			return err
+		}
+	case *string:
+		*p = string(text)
+	case *[]byte:
+		*p = bytes.Clone(text)
+	default:
+		r := strings.NewReader(string(text))
+		if _, err := fmt.Fscan(r, p); err != nil {
+			return err
+		} else if r.Len() > 0 {
+			return fmt.Errorf("unexpected text after value: %q", text[len(text)-r.Len():])
+		}
+	}
+	*o = Of(t)
+	return nil
+}
+
+// Scan implements sql.Scanner, so opts can be used as destinations in sql.Rows.Scan.
+// A NULL column scans to an empty opt.
+// Other values are converted to T following the same rules as sql.Null.
+func (o *Opt[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+
+	if n.Valid {
+		*o = Of(n.V)
+	} else {
+		*o = Empty[T]()
+	}
+	return nil
+}
+
+// Value implements driver.Valuer, so opts can be used as query arguments.
+// Empty opts are stored as NULL, and the values of ok opts are converted with
+// driver.DefaultParameterConverter, which also handles values implementing driver.Valuer.
+// Opts holding any other error than ErrEmpty return an error wrapping ErrEncode.
+func (o Opt[T]) Value() (driver.Value, error) {
+	if err := o.encodeErr(); err != nil {
+		return nil, err
+	} else if o.err == ErrEmpty {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.val)
+}
@@ -0,0 +1,34 @@
+//go:build go1.24
+
+package opt
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// The omitzero option was added to encoding/json in Go 1.24
+
+func TestJSONOmitZero(t *testing.T) {
+	type omitPayload struct {
+		Name Opt[string] `json:"name,omitzero"`
+		Age  Opt[int]    `json:"age,omitzero"`
+	}
+
+	data, err := json.Marshal(omitPayload{Name: Of("bob"), Age: Empty[int]()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `{"name":"bob"}` {
+		t.Fatalf("unexpected json: %s", data)
+	}
+
+	// Zero opts are ok, and must not be omitted
+	data, err = json.Marshal(omitPayload{Name: Zero[string](), Age: Zero[int]()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `{"name":"","age":0}` {
+		t.Fatalf("unexpected json: %s", data)
+	}
+}
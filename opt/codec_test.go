@@ -0,0 +1,228 @@
+package opt
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/netip"
+	"testing"
+)
+
+type payload struct {
+	Name Opt[string] `json:"name"`
+	Age  Opt[int]    `json:"age"`
+}
+
+func TestJSONMarshal(t *testing.T) {
+	data, err := json.Marshal(payload{Name: Of("bob"), Age: Empty[int]()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `{"name":"bob","age":null}` {
+		t.Fatalf("unexpected json: %s", data)
+	}
+
+	_, err = json.Marshal(payload{Name: ErrorOf[string](theError)})
+	if !errors.Is(err, ErrEncode) || !errors.Is(err, theError) {
+		t.Fatalf("expected ErrEncode and the error, got: %v", err)
+	}
+}
+
+func TestJSONUnmarshal(t *testing.T) {
+	var p payload
+	if err := json.Unmarshal([]byte(`{"name":"bob","age":null}`), &p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	is(t, p.Name, "bob")
+	isError(t, p.Age, ErrEmpty)
+
+	// Absent is not the same as empty: absent fields keep their previous value,
+	// which is the zero opt for a new struct
+	p = payload{}
+	if err := json.Unmarshal([]byte(`{"age":27}`), &p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	is(t, p.Name, "")
+	is(t, p.Age, 27)
+
+	// So fields must be initialized with Empty, if absent fields should be empty
+	p = payload{Name: Empty[string](), Age: Empty[int]()}
+	if err := json.Unmarshal([]byte(`{"age":27}`), &p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	isError(t, p.Name, ErrEmpty)
+	is(t, p.Age, 27)
+
+	if err := json.Unmarshal([]byte(`{"age":"x"}`), &p); err == nil {
+		t.Fatalf("expected error for bad type")
+	}
+}
+
+func TestText(t *testing.T) {
+	text, err := Of(27).MarshalText()
+	if err != nil || string(text) != "27" {
+		t.Fatalf("unexpected text %q, err: %v", text, err)
+	}
+
+	text, err = Of(netip.MustParseAddr("10.0.0.1")).MarshalText()
+	if err != nil || string(text) != "10.0.0.1" {
+		t.Fatalf("unexpected text %q, err: %v", text, err)
+	}
+
+	text, err = Empty[int]().MarshalText()
+	if err != nil || len(text) != 0 {
+		t.Fatalf("unexpected text %q, err: %v", text, err)
+	}
+
+	if _, err = ErrorOf[int](theError).MarshalText(); !errors.Is(err, ErrEncode) {
+		t.Fatalf("expected ErrEncode, got: %v", err)
+	}
+
+	var oi Opt[int]
+	if err = oi.UnmarshalText([]byte("27")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	is(t, oi, 27)
+
+	if err = oi.UnmarshalText(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	isError(t, oi, ErrEmpty)
+
+	// Text left after the value is an error
+	for _, text := range []string{"12abc", "1 2", "27 "} {
+		if err = oi.UnmarshalText([]byte(text)); err == nil {
+			t.Fatalf("expected error for %q, got: %v", text, oi)
+		}
+	}
+	var of Opt[float64]
+	if err = of.UnmarshalText([]byte("1.5xyz")); err == nil {
+		t.Fatalf("expected error, got: %v", of)
+	}
+	var ob Opt[bool]
+	if err = ob.UnmarshalText([]byte("truefalse")); err == nil {
+		t.Fatalf("expected error, got: %v", ob)
+	}
+	if err = ob.UnmarshalText([]byte("true")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	is(t, ob, true)
+
+	var oa Opt[netip.Addr]
+	if err = oa.UnmarshalText([]byte("10.0.0.1")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	is(t, oa, netip.MustParseAddr("10.0.0.1"))
+
+	var ostr Opt[string]
+	if err = ostr.UnmarshalText([]byte("hello world")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	is(t, ostr, "hello world")
+
+	// As map keys in JSON
+	var m map[Opt[int]]string
+	if err = json.Unmarshal([]byte(`{"1":"one"}`), &m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m[Of(1)] != "one" {
+		t.Fatalf("unexpected map: %v", m)
+	}
+}
+
+func TestSQLValue(t *testing.T) {
+	v, err := Of(27).Value()
+	if err != nil || v != int64(27) {
+		t.Fatalf("unexpected value %v, err: %v", v, err)
+	}
+
+	v, err = Empty[string]().Value()
+	if err != nil || v != nil {
+		t.Fatalf("unexpected value %v, err: %v", v, err)
+	}
+
+	if _, err = ErrorOf[int](theError).Value(); !errors.Is(err, ErrEncode) || !errors.Is(err, theError) {
+		t.Fatalf("expected ErrEncode and the error, got: %v", err)
+	}
+}
+
+func TestSQLScan(t *testing.T) {
+	var oi Opt[int]
+	if err := oi.Scan(int64(27)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	is(t, oi, 27)
+
+	if err := oi.Scan(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	isError(t, oi, ErrEmpty)
+
+	if err := oi.Scan("x"); err == nil {
+		t.Fatalf("expected error scanning a string into an int")
+	}
+}
+
+func TestSQLFakeDriver(t *testing.T) {
+	db := sql.OpenDB(fakeConnector{})
+	defer db.Close()
+
+	// The fake driver echoes the query arguments back as a single row
+	var name Opt[string]
+	var age Opt[int]
+	err := db.QueryRow("echo", Of("bob"), Empty[int]()).Scan(&name, &age)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	is(t, name, "bob")
+	isError(t, age, ErrEmpty)
+
+	_, err = db.Exec("echo", ErrorOf[int](theError))
+	if !errors.Is(err, ErrEncode) {
+		t.Fatalf("expected ErrEncode, got: %v", err)
+	}
+}
+
+// fakeConnector is a minimal database/sql driver where all statements return
+// their arguments as a single row.
+type fakeConnector struct{}
+
+type fakeConn struct{}
+
+type fakeStmt struct{}
+
+type fakeRows struct {
+	row []driver.Value
+}
+
+func (c fakeConnector) Connect(_ context.Context) (driver.Conn, error) { return fakeConn{}, nil }
+func (c fakeConnector) Driver() driver.Driver                          { return nil }
+
+func (c fakeConn) Prepare(_ string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (c fakeConn) Close() error                          { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)             { return nil, errors.New("not supported") }
+
+func (s fakeStmt) Close() error  { return nil }
+func (s fakeStmt) NumInput() int { return -1 }
+func (s fakeStmt) Exec(_ []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{row: args}, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	return make([]string, len(r.row))
+}
+func (r *fakeRows) Close() error { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.row == nil {
+		return io.EOF
+	}
+	copy(dest, r.row)
+	r.row = nil
+	return nil
+}
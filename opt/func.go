@@ -15,3 +15,62 @@ func Map[S, T any](opt Opt[S], f func(S) T) Opt[T] {
 func Ok[T any](opt Opt[T]) bool {
 	return opt.Ok()
 }
+
+// FlatMap converts an option into some other type via a function that can itself fail.
+// If the opt is empty or an error the function will not be called, and the error is kept.
+//
+// Example, chaining two fallible steps:
+//
+//	port := opt.FlatMap(opt.Returning(readConfig("port")), opt.Mapper(strconv.Atoi))
+func FlatMap[S, T any](opt Opt[S], f func(S) Opt[T]) Opt[T] {
+	if opt.err != nil {
+		return ErrorOf[T](opt.err)
+	}
+	return f(opt.val)
+}
+
+// OrElse returns the opt if it is ok, and otherwise calls f to produce a fallback.
+// Unlike Opt.Or, the fallback is only computed when needed, and it can itself be empty or an error.
+func OrElse[T any](opt Opt[T], f func() Opt[T]) Opt[T] {
+	if opt.err != nil {
+		return f()
+	}
+	return opt
+}
+
+// Filter returns an empty opt if the opt is ok, but the value does not match the predicate.
+// Empty and error opts are returned unchanged.
+func Filter[T any](opt Opt[T], pred func(T) bool) Opt[T] {
+	if opt.err == nil && !pred(opt.val) {
+		return Empty[T]()
+	}
+	return opt
+}
+
+// Zip combines the values of two opts with a function.
+// If either opt is empty or an error, the function is not called and an empty or error opt is returned.
+// If both are empty or errors, errors take precedence over empty opts, and the first opt over the second.
+// To combine two opts into a seq.Tuple, see seq.ZipOpt.
+func Zip[X, Y, T any](ox Opt[X], oy Opt[Y], f func(X, Y) T) Opt[T] {
+	if ox.err != nil && (ox.err != ErrEmpty || oy.err == nil || oy.err == ErrEmpty) {
+		return ErrorOf[T](ox.err)
+	} else if oy.err != nil {
+		return ErrorOf[T](oy.err)
+	}
+	return Of(f(ox.val, oy.val))
+}
+
+// MapErr converts the error of an opt, fx. to wrap it with more context.
+// Empty opts are not considered errors, and are returned unchanged, as are ok opts.
+//
+// Example:
+//
+//	cfg := opt.MapErr(loadConfig(path), func(err error) error {
+//	    return fmt.Errorf("loading %s: %w", path, err)
+//	})
+func MapErr[T any](opt Opt[T], f func(error) error) Opt[T] {
+	if opt.err == nil || opt.err == ErrEmpty {
+		return opt
+	}
+	return ErrorOf[T](f(opt.err))
+}
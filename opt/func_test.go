@@ -0,0 +1,69 @@
+package opt
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func TestFlatMap(t *testing.T) {
+	atoi := Mapper(strconv.Atoi)
+
+	is(t, FlatMap(Of("27"), atoi), 27)
+	isError(t, FlatMap(Empty[string](), atoi), ErrEmpty)
+	isError(t, FlatMap(ErrorOf[string](theError), atoi), theError)
+
+	_, err := strconv.Atoi("x")
+	if got := FlatMap(Of("x"), atoi).Error(); got == nil || got.Error() != err.Error() {
+		t.Fatalf("expected Atoi error, got: %v", got)
+	}
+}
+
+func TestOrElse(t *testing.T) {
+	calls := 0
+	fallback := func() Opt[int] {
+		calls++
+		return Of(2)
+	}
+
+	is(t, OrElse(Of(1), fallback), 1)
+	if calls != 0 {
+		t.Fatalf("fallback should not be called for ok opts")
+	}
+	is(t, OrElse(Empty[int](), fallback), 2)
+	is(t, OrElse(ErrorOf[int](theError), fallback), 2)
+	isError(t, OrElse(Empty[int](), func() Opt[int] { return ErrorOf[int](theError) }), theError)
+}
+
+func TestFilter(t *testing.T) {
+	isPositive := func(i int) bool { return i > 0 }
+
+	is(t, Filter(Of(1), isPositive), 1)
+	isError(t, Filter(Of(-1), isPositive), ErrEmpty)
+	isError(t, Filter(ErrorOf[int](theError), isPositive), theError)
+}
+
+func TestZip(t *testing.T) {
+	add := func(x int, y string) string { return fmt.Sprint(x, y) }
+
+	is(t, Zip(Of(1), Of("a"), add), "1a")
+	isError(t, Zip(Empty[int](), Of("a"), add), ErrEmpty)
+	isError(t, Zip(Of(1), Empty[string](), add), ErrEmpty)
+	isError(t, Zip(ErrorOf[int](theError), Of("a"), add), theError)
+	isError(t, Zip(Of(1), ErrorOf[string](theError), add), theError)
+	isError(t, Zip(Empty[int](), ErrorOf[string](theError), add), theError)
+	isError(t, Zip(ErrorOf[int](theError), ErrorOf[string](atTheDiscoError), add), theError)
+}
+
+func TestMapErr(t *testing.T) {
+	wrap := func(err error) error { return fmt.Errorf("wrapped: %w", err) }
+
+	is(t, MapErr(Of(1), wrap), 1)
+	isError(t, MapErr(Empty[int](), wrap), ErrEmpty)
+
+	err := MapErr(ErrorOf[int](theError), wrap).Error()
+	if !errors.Is(err, theError) || err.Error() != "wrapped: the error" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -1,13 +1,20 @@
 package opt
 
 import (
-	"sync"
+	"context"
+	"sync/atomic"
+	"time"
 )
 
 // Future represents a result that will appear at some point in the future.
 type Future[T any] struct {
-	val Opt[T]
-	wg  sync.WaitGroup
+	val      Opt[T]
+	done     chan struct{} // closed when val is set
+	resolved atomic.Bool
+}
+
+func newFuture[T any]() *Future[T] {
+	return &Future[T]{done: make(chan struct{})}
 }
 
 // Promise executes a function in a goroutine and returns a Future that can be used to wait for the result.
@@ -16,12 +23,12 @@ type Future[T any] struct {
 // to other functions and goroutines.
 //
 // Calling resolve multiple times will cause a panic. Failing to call resolve will cause Future.Await to hang forever.
+// To guard against that, use Future.AwaitContext or Future.AwaitTimeout, or create the promise with PromiseContext.
 //
 // If the exec function panics it will be recovered and Future.Await will return an option with an ErrPanic.
 func Promise[T any](exec func(resolve func(Opt[T]))) *Future[T] {
-	res := &Future[T]{}
+	res := newFuture[T]()
 
-	res.wg.Add(1)
 	go func() {
 		defer res.recoverPanic()
 		exec(res.resolve)
@@ -30,16 +37,79 @@ func Promise[T any](exec func(resolve func(Opt[T]))) *Future[T] {
 	return res
 }
 
-func (fut *Future[T]) resolve(val Opt[T]) {
+// PromiseContext is like Promise, but passes a context to the exec function.
+// The context is derived from ctx and is cancelled when the promise is resolved,
+// so the exec function can use it to stop any work it has started.
+//
+// Like with Promise, calling resolve more than once will cause a panic.
+// If ctx is cancelled before the promise is resolved, the future is resolved with
+// an error opt holding ctx.Err(), and the first call to resolve after that is ignored.
+// This means that a PromiseContext with a cancellable context never hangs forever,
+// even if exec fails to call resolve.
+//
+// Example, fetching a URL with a timeout:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	fut := opt.PromiseContext(ctx, func(ctx context.Context, resolve func(opt.Opt[*http.Response])) {
+//		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+//		resolve(opt.Returning(http.DefaultClient.Do(req)))
+//	})
+func PromiseContext[T any](ctx context.Context, exec func(ctx context.Context, resolve func(Opt[T]))) *Future[T] {
+	res := newFuture[T]()
+	ctx, cancel := context.WithCancel(ctx)
+
+	context.AfterFunc(ctx, func() {
+		res.tryResolve(ErrorOf[T](ctx.Err()))
+	})
+	var called atomic.Bool
+	resolve := func(val Opt[T]) {
+		if !called.CompareAndSwap(false, true) {
+			panic("promise resolved more than once")
+		}
+		if err := ctx.Err(); err != nil {
+			// The AfterFunc may not have run yet, but the context was cancelled first
+			val = ErrorOf[T](err)
+		}
+		res.tryResolve(val)
+		cancel()
+	}
+
+	go func() {
+		defer func() {
+			if e := recover(); e != nil {
+				res.tryResolve(ErrorOf[T](ErrPanic{V: e}))
+				cancel()
+			}
+		}()
+		exec(ctx, resolve)
+	}()
+
+	return res
+}
+
+// tryResolve sets the result of the future, unless it is already resolved.
+// It returns false if the future was already resolved.
+func (fut *Future[T]) tryResolve(val Opt[T]) bool {
+	if !fut.resolved.CompareAndSwap(false, true) {
+		return false
+	}
 	fut.val = val
-	fut.wg.Done()
+	close(fut.done)
+	return true
+}
+
+func (fut *Future[T]) resolve(val Opt[T]) {
+	if !fut.tryResolve(val) {
+		panic("promise resolved more than once")
+	}
 }
 
 func (fut *Future[T]) recoverPanic() {
 	if e := recover(); e != nil {
-		fut.val = ErrorOf[T](ErrPanic{
+		fut.tryResolve(ErrorOf[T](ErrPanic{
 			V: e,
-		})
+		}))
 	}
 }
 
@@ -47,10 +117,66 @@ func (fut *Future[T]) recoverPanic() {
 // If the result is already available the function returns immediately.
 // It is valid to call from any goroutine and as many times as you like.
 func (fut *Future[T]) Await() Opt[T] {
-	fut.wg.Wait()
+	<-fut.done
 	return fut.val
 }
 
+// AwaitContext blocks until the result is ready or the context is done.
+// If the context is done first, it returns an error opt with ctx.Err().
+// The future itself is not affected by the context, and can be awaited again later.
+func (fut *Future[T]) AwaitContext(ctx context.Context) Opt[T] {
+	select {
+	case <-fut.done:
+		return fut.val
+	default:
+	}
+
+	select {
+	case <-fut.done:
+		return fut.val
+	case <-ctx.Done():
+		return ErrorOf[T](ctx.Err())
+	}
+}
+
+// AwaitTimeout blocks until the result is ready, or at most for the duration d.
+// If the result is not ready in time, it returns an error opt with context.DeadlineExceeded.
+// The future itself is not affected by the timeout, and can be awaited again later.
+func (fut *Future[T]) AwaitTimeout(d time.Duration) Opt[T] {
+	select {
+	case <-fut.done:
+		return fut.val
+	default:
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-fut.done:
+		return fut.val
+	case <-timer.C:
+		return ErrorOf[T](context.DeadlineExceeded)
+	}
+}
+
+// Done returns a channel that is closed when the result is ready.
+// This makes it possible to wait for futures in select statements, together with other channels.
+// When the channel is closed Await returns immediately.
+//
+// Example:
+//
+//	select {
+//	case <-fut.Done():
+//		res := fut.Await()
+//		...
+//	case <-shutdown:
+//		return
+//	}
+func (fut *Future[T]) Done() <-chan struct{} {
+	return fut.done
+}
+
 // Then starts another promise result of this future is ready.
 // The chained promise is started whether the first result is an error or not.
 // If you need to change the type of the result you must use PromiseThen.
@@ -61,9 +187,8 @@ func (fut *Future[T]) Then(exec func(firstResult Opt[T], resolve func(Opt[T])))
 // PromiseThen starts another promise when the result of a future is ready.
 // The chained promise is started whether the first result is an error or not.
 func PromiseThen[S, T any](first *Future[S], exec func(firstResult Opt[S], resolve func(Opt[T]))) *Future[T] {
-	fut := &Future[T]{}
+	fut := newFuture[T]()
 
-	fut.wg.Add(1)
 	go func() {
 		defer fut.recoverPanic()
 		exec(first.Await(), fut.resolve)
@@ -1,6 +1,7 @@
 package opt
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 	"time"
@@ -67,3 +68,109 @@ func TestPromiseThen(t *testing.T) {
 		}
 	}
 }
+
+func TestPromisePanic(t *testing.T) {
+	fut := Promise(func(resolve func(opt Opt[int])) {
+		panic("at the disco")
+	})
+	isError(t, fut.Await(), atTheDiscoError)
+}
+
+func TestFutureDone(t *testing.T) {
+	release := make(chan struct{})
+	fut := Promise(func(resolve func(opt Opt[int])) {
+		<-release
+		resolve(Of(27))
+	})
+
+	select {
+	case <-fut.Done():
+		t.Fatalf("future must not be done before it is resolved")
+	default:
+	}
+
+	close(release)
+	<-fut.Done()
+	is(t, fut.Await(), 27)
+}
+
+func TestFutureAwaitContext(t *testing.T) {
+	release := make(chan struct{})
+	fut := Promise(func(resolve func(opt Opt[int])) {
+		<-release
+		resolve(Of(27))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	isError(t, fut.AwaitContext(ctx), context.Canceled)
+	isError(t, fut.AwaitTimeout(time.Millisecond), context.DeadlineExceeded)
+
+	// The future is not affected by the context
+	close(release)
+	<-fut.Done()
+	is(t, fut.AwaitContext(ctx), 27)
+	is(t, fut.AwaitTimeout(time.Millisecond), 27)
+}
+
+func TestPromiseContext(t *testing.T) {
+	fut := PromiseContext(context.Background(), func(ctx context.Context, resolve func(opt Opt[int])) {
+		if ctx.Err() != nil {
+			t.Errorf("context must not be done before resolve: %v", ctx.Err())
+		}
+		resolve(Of(27))
+		if ctx.Err() == nil {
+			t.Errorf("context must be cancelled after resolve")
+		}
+	})
+	is(t, fut.Await(), 27)
+}
+
+func TestPromiseContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	fut := PromiseContext(ctx, func(ctx context.Context, resolve func(opt Opt[int])) {
+		<-ctx.Done()
+		close(stopped)
+		resolve(Of(27)) // ignored, since the future is already resolved
+	})
+
+	cancel()
+	isError(t, fut.Await(), context.Canceled)
+	<-stopped
+	isError(t, fut.Await(), context.Canceled)
+}
+
+func TestPromiseContextForgottenResolve(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	fut := PromiseContext(ctx, func(ctx context.Context, resolve func(opt Opt[int])) {
+		// never resolves
+	})
+	isError(t, fut.Await(), context.DeadlineExceeded)
+}
+
+func TestPromiseContextPanic(t *testing.T) {
+	var execCtx context.Context
+	fut := PromiseContext(context.Background(), func(ctx context.Context, resolve func(opt Opt[int])) {
+		execCtx = ctx
+		panic("at the disco")
+	})
+	isError(t, fut.Await(), atTheDiscoError)
+	<-execCtx.Done()
+}
+
+func TestPromiseContextResolveTwice(t *testing.T) {
+	panicked := make(chan any, 1)
+	fut := PromiseContext(context.Background(), func(ctx context.Context, resolve func(opt Opt[int])) {
+		defer func() {
+			panicked <- recover()
+		}()
+		resolve(Of(27))
+		resolve(Of(28))
+	})
+	is(t, fut.Await(), 27)
+	if e := <-panicked; e != "promise resolved more than once" {
+		t.Fatalf("expected panic on second resolve, got: %v", e)
+	}
+}
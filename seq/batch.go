@@ -0,0 +1,148 @@
+package seq
+
+import (
+	"time"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+// FuncTimer returns a channel that receives a value when a timer expires,
+// like time.After does. It is used with BatchTimerOf to decide when to flush a batch.
+type FuncTimer func() <-chan time.Time
+
+type batchSeq[T any] struct {
+	ch      Chan[T]
+	maxSize int
+	timer   FuncTimer
+}
+
+// BatchOf reads a channel in batches of up to maxSize elements. A batch is returned
+// when it has maxSize elements, or when maxWait has passed since the first element
+// in the batch was received, whichever comes first. When the channel is closed,
+// any partial batch is returned and the seq ends. Empty batches are never returned.
+//
+// Like Chan, the batch seq is stateful and reading a batch blocks until at least one element
+// is available.
+//
+// Example, inserting micro-batches of max 100 elements, with a max latency of 1 second:
+//
+//	seq.BatchOf(events, 100, time.Second).ForEach(func(batch seq.Slice[Event]) {
+//	    db.InsertEvents(batch)
+//	})
+func BatchOf[T any](ch Chan[T], maxSize int, maxWait time.Duration) Seq[Slice[T]] {
+	return BatchTimerOf(ch, maxSize, func() <-chan time.Time {
+		return time.After(maxWait)
+	})
+}
+
+// BatchTimerOf is like BatchOf, but the max latency of a batch is determined by a FuncTimer.
+// The timer function is called when the first element of a batch is received, and
+// the batch is returned when the returned channel receives a value.
+// This is mainly useful for testing where you need to control time deterministically.
+func BatchTimerOf[T any](ch Chan[T], maxSize int, timer FuncTimer) Seq[Slice[T]] {
+	if maxSize <= 0 {
+		panic("batch size must be > 0")
+	}
+	return batchSeq[T]{
+		ch:      ch,
+		maxSize: maxSize,
+		timer:   timer,
+	}
+}
+
+func (b batchSeq[T]) ForEach(f Func1[Slice[T]]) opt.Opt[Slice[T]] {
+	var (
+		fst  opt.Opt[Slice[T]]
+		tail Seq[Slice[T]]
+	)
+	for fst, tail = b.First(); fst.Ok(); fst, tail = tail.First() {
+		f(fst.Must())
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (b batchSeq[T]) ForEachIndex(f Func2[int, Slice[T]]) opt.Opt[Slice[T]] {
+	var (
+		fst  opt.Opt[Slice[T]]
+		tail Seq[Slice[T]]
+		i    = 0
+	)
+	for fst, tail = b.First(); fst.Ok(); fst, tail = tail.First() {
+		f(i, fst.Must())
+		i++
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (b batchSeq[T]) Len() (int, bool) {
+	return LenUnknown, false
+}
+
+func (b batchSeq[T]) ToSlice() Slice[Slice[T]] {
+	var arr []Slice[T]
+	b.ForEach(func(batch Slice[T]) {
+		arr = append(arr, batch)
+	})
+	return arr
+}
+
+func (b batchSeq[T]) Limit(n int) Seq[Slice[T]] {
+	return LimitOf[Slice[T]](b, n)
+}
+
+func (b batchSeq[T]) Take(n int) (Slice[Slice[T]], Seq[Slice[T]]) {
+	return takeFirst[Slice[T]](b, n)
+}
+
+func (b batchSeq[T]) TakeWhile(pred Predicate[Slice[T]]) (Slice[Slice[T]], Seq[Slice[T]]) {
+	return takeWhileFirst[Slice[T]](b, pred)
+}
+
+func (b batchSeq[T]) Skip(n int) Seq[Slice[T]] {
+	return skipFirst[Slice[T]](b, n)
+}
+
+func (b batchSeq[T]) Where(pred Predicate[Slice[T]]) Seq[Slice[T]] {
+	return whereSeq[Slice[T]]{
+		seq:  b,
+		pred: pred,
+	}
+}
+
+func (b batchSeq[T]) While(pred Predicate[Slice[T]]) Seq[Slice[T]] {
+	return whileSeq[Slice[T]]{
+		seq:  b,
+		pred: pred,
+	}
+}
+
+func (b batchSeq[T]) First() (opt.Opt[Slice[T]], Seq[Slice[T]]) {
+	t, ok := <-b.ch
+	if !ok {
+		return opt.Empty[Slice[T]](), Empty[Slice[T]]()
+	}
+
+	batch := make(Slice[T], 1, b.maxSize)
+	batch[0] = t
+	timeout := b.timer()
+	for len(batch) < b.maxSize {
+		select {
+		case t, ok = <-b.ch:
+			if !ok {
+				return opt.Of(batch), Empty[Slice[T]]()
+			}
+			batch = append(batch, t)
+		case <-timeout:
+			return opt.Of(batch), b
+		}
+	}
+
+	return opt.Of(batch), b
+}
+
+func (b batchSeq[T]) Map(m FuncMap[Slice[T], Slice[T]]) Seq[Slice[T]] {
+	return mappedSeq[Slice[T], Slice[T]]{
+		f:   m,
+		seq: b,
+	}
+}
@@ -0,0 +1,67 @@
+package seq_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+// noTimeout is a seq.FuncTimer that never fires
+func noTimeout() <-chan time.Time {
+	return nil
+}
+
+func TestBatchSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Slice[int]] {
+		return seq.BatchTimerOf(chanWithVals(1, 2, 3, 4, 5), 2, noTimeout)
+	}
+	fntesting.SuiteOf(t, createSeq).
+		WithEqual(sliceEqual[int]).
+		Is(seq.SliceAsArgs(1, 2), seq.SliceAsArgs(3, 4), seq.SliceAsArgs(5))
+}
+
+func TestBatchClosed(t *testing.T) {
+	ch := make(chan int)
+	close(ch)
+	fst, _ := seq.BatchOf(ch, 10, time.Hour).First()
+	if !fst.Empty() {
+		t.Fatalf("expected empty batch: %v", fst)
+	}
+}
+
+func TestBatchTimeout(t *testing.T) {
+	ch := make(chan int)
+	tick := make(chan time.Time)
+	go func() {
+		ch <- 1
+		tick <- time.Time{}
+		ch <- 2
+		ch <- 3
+		close(ch)
+	}()
+
+	batches := seq.BatchTimerOf(ch, 10, func() <-chan time.Time {
+		return tick
+	}).ToSlice()
+
+	if !reflect.DeepEqual(batches, seq.SliceAsArgs(seq.SliceAsArgs(1), seq.SliceAsArgs(2, 3))) {
+		t.Fatalf("unexpected batches: %v", batches)
+	}
+}
+
+func TestBatchRealTimer(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		ch <- 1
+	}()
+
+	start := time.Now()
+	fst, _ := seq.BatchOf(ch, 10, time.Millisecond).First()
+	fntesting.TestOf(t, fst.Must().Seq()).Is(1)
+	if time.Since(start) < time.Millisecond {
+		t.Fatalf("batch returned before timeout")
+	}
+}
@@ -0,0 +1,173 @@
+package seq
+
+import (
+	"sync"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+// cache holds the elements read from the source seq of a CacheOf.
+type cache[T any] struct {
+	mu    sync.Mutex
+	src   Seq[T]
+	elems []T
+	err   error // set when src is exhausted or fails
+}
+
+type cacheSeq[T any] struct {
+	c   *cache[T]
+	pos int // index of the first element of this seq
+}
+
+// CacheOf wraps a seq, recording the elements as they are read from it.
+// The returned seq can be executed any number of times, also if the input seq is stateful,
+// like a channel or a reader. The input seq is only executed once, and only as far as needed.
+// Once the input is exhausted, the returned seq has a well-defined length.
+// If the input seq fails, the error is returned every time the returned seq reaches it.
+//
+// The returned seq is safe to execute from several goroutines at the same time.
+// Since all elements are kept in memory, it should not be used with very long or infinite seqs.
+//
+// Example, reading the first lines of a file twice:
+//
+//	lines := seq.CacheOf(seqio.LinesOf(r))
+//	header, _ := lines.Take(10)
+//	all := lines.ToSlice() // also includes the first 10 lines
+func CacheOf[T any](seq Seq[T]) Seq[T] {
+	return cacheSeq[T]{c: &cache[T]{src: seq}}
+}
+
+// get returns the element at index i, reading from the source as needed.
+func (c *cache[T]) get(i int) opt.Opt[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i >= len(c.elems) && c.err == nil {
+		fst, tail := c.src.First()
+		c.src = tail
+		if t, err := fst.Return(); err != nil {
+			c.err = err
+			c.src = nil // we will never read it again, so free it
+		} else {
+			c.elems = append(c.elems, t)
+		}
+	}
+
+	if i < len(c.elems) {
+		return opt.Of(c.elems[i])
+	}
+	return opt.ErrorOf[T](c.err)
+}
+
+func (c cacheSeq[T]) ForEach(f Func1[T]) opt.Opt[T] {
+	var fst opt.Opt[T]
+	for i := c.pos; ; i++ {
+		fst = c.c.get(i)
+		if !fst.Ok() {
+			break
+		}
+		f(fst.Must())
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (c cacheSeq[T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	i := 0
+	return c.ForEach(func(t T) {
+		f(i, t)
+		i++
+	})
+}
+
+func (c cacheSeq[T]) Len() (int, bool) {
+	c.c.mu.Lock()
+	defer c.c.mu.Unlock()
+
+	if c.c.err == opt.ErrEmpty {
+		return max(len(c.c.elems)-c.pos, 0), true
+	} else if c.c.err != nil {
+		return LenUnknown, false
+	}
+
+	if sz, ok := c.c.src.Len(); ok {
+		return max(len(c.c.elems)+sz-c.pos, 0), true
+	} else if sz == LenInfinite {
+		return LenInfinite, false
+	}
+	return LenUnknown, false
+}
+
+func (c cacheSeq[T]) ToSlice() Slice[T] {
+	var arr []T
+	c.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (c cacheSeq[T]) Limit(n int) Seq[T] {
+	return LimitOf[T](c, n)
+}
+
+func (c cacheSeq[T]) Take(n int) (Slice[T], Seq[T]) {
+	var arr []T
+	for i := 0; i < n; i++ {
+		fst := c.c.get(c.pos + i)
+		if err := fst.Error(); err != nil {
+			return arr, ErrorOf[T](err)
+		}
+		arr = append(arr, fst.Must())
+	}
+	if arr == nil {
+		arr = []T{}
+	}
+	return arr, cacheSeq[T]{c: c.c, pos: c.pos + n}
+}
+
+func (c cacheSeq[T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	var arr []T
+	for i := c.pos; ; i++ {
+		fst := c.c.get(i)
+		if err := fst.Error(); err != nil {
+			return arr, ErrorOf[T](err)
+		}
+		if t := fst.Must(); pred(t) {
+			arr = append(arr, t)
+		} else {
+			return arr, cacheSeq[T]{c: c.c, pos: i}
+		}
+	}
+}
+
+func (c cacheSeq[T]) Skip(n int) Seq[T] {
+	return cacheSeq[T]{c: c.c, pos: c.pos + n}
+}
+
+func (c cacheSeq[T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  c,
+		pred: pred,
+	}
+}
+
+func (c cacheSeq[T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  c,
+		pred: pred,
+	}
+}
+
+func (c cacheSeq[T]) First() (opt.Opt[T], Seq[T]) {
+	fst := c.c.get(c.pos)
+	if err := fst.Error(); err != nil {
+		return fst, ErrorOf[T](err)
+	}
+	return fst, cacheSeq[T]{c: c.c, pos: c.pos + 1}
+}
+
+func (c cacheSeq[T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: c,
+	}
+}
@@ -0,0 +1,75 @@
+package seq_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func TestCacheSuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.CacheOf(seq.ChanOf(chanWithVals(1, 2, 3, 4)))
+	}
+	fntesting.SuiteOf(t, createSeq).Is(1, 2, 3, 4)
+}
+
+func TestCacheReplay(t *testing.T) {
+	sq := seq.CacheOf(seq.ChanOf(chanWithVals(1, 2, 3, 4)))
+	if _, ok := sq.Len(); ok {
+		t.Fatal("length should be unknown before the source is exhausted")
+	}
+
+	head, tail := sq.Take(2)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2)
+	fntesting.TestOf(t, sq).Is(1, 2, 3, 4)
+	fntesting.TestOf(t, tail).Is(3, 4)
+
+	fntesting.TestOf(t, sq).LenIs(4)
+	fntesting.TestOf(t, tail).LenIs(2)
+}
+
+func TestCacheLazy(t *testing.T) {
+	calls := 0
+	src := seq.SourceOf(func() int {
+		calls++
+		return calls
+	})
+	sq := seq.CacheOf(src)
+
+	head, _ := sq.Take(3)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2, 3)
+	head, _ = sq.Take(2)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2)
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestCacheError(t *testing.T) {
+	theError := errors.New("the error")
+	calls := 0
+	src := seq.ConcatOf(
+		seq.MappingOf(seq.SliceOfArgs(1, 2), func(i int) int {
+			calls++
+			return i
+		}),
+		seq.ErrorOf[int](theError))
+	sq := seq.CacheOf(src)
+
+	for i := 0; i < 2; i++ {
+		var arr []int
+		res := sq.ForEach(func(i int) {
+			arr = append(arr, i)
+		})
+		if res.Error() != theError {
+			t.Fatalf("expected the error, got: %v", res.Error())
+		}
+		fntesting.TestOf(t, seq.SliceOf(arr)).Is(1, 2)
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected the source to be executed once, got %d calls", calls)
+	}
+}
@@ -0,0 +1,293 @@
+package seq
+
+import (
+	"slices"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+type chunkSeq[T any] struct {
+	seq Seq[T]
+	n   int
+}
+
+// ChunkOf splits a Seq into chunks of n elements. The last chunk may have fewer than n elements.
+// Like SplitOf, the chunking is "semi-lazy": Each chunk is read eagerly into a Slice,
+// but the tail is not executed.
+//
+// If the length of the input seq is well-defined, so is the length of the chunked seq.
+//
+// Example, batching database writes:
+//
+//	seq.ChunkOf(rows, 100).ForEach(func(batch seq.Slice[Row]) {
+//	    db.InsertRows(batch)
+//	})
+func ChunkOf[T any](seq Seq[T], n int) Seq[Slice[T]] {
+	if n <= 0 {
+		panic("chunk size must be > 0")
+	}
+	return chunkSeq[T]{
+		seq: seq,
+		n:   n,
+	}
+}
+
+func (c chunkSeq[T]) ForEach(f Func1[Slice[T]]) opt.Opt[Slice[T]] {
+	var (
+		fst  opt.Opt[Slice[T]]
+		tail Seq[Slice[T]]
+	)
+	for fst, tail = c.First(); fst.Ok(); fst, tail = tail.First() {
+		f(fst.Must())
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (c chunkSeq[T]) ForEachIndex(f Func2[int, Slice[T]]) opt.Opt[Slice[T]] {
+	var (
+		fst  opt.Opt[Slice[T]]
+		tail Seq[Slice[T]]
+		i    = 0
+	)
+	for fst, tail = c.First(); fst.Ok(); fst, tail = tail.First() {
+		f(i, fst.Must())
+		i++
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (c chunkSeq[T]) Len() (int, bool) {
+	sz, ok := c.seq.Len()
+	if ok {
+		return (sz + c.n - 1) / c.n, true
+	}
+	return sz, false
+}
+
+func (c chunkSeq[T]) ToSlice() Slice[Slice[T]] {
+	var arr []Slice[T]
+	if sz, ok := c.Len(); ok {
+		arr = make([]Slice[T], 0, sz)
+	}
+	c.ForEach(func(chunk Slice[T]) {
+		arr = append(arr, chunk)
+	})
+	return arr
+}
+
+func (c chunkSeq[T]) Limit(n int) Seq[Slice[T]] {
+	return LimitOf[Slice[T]](c, n)
+}
+
+func (c chunkSeq[T]) Take(n int) (Slice[Slice[T]], Seq[Slice[T]]) {
+	return takeFirst[Slice[T]](c, n)
+}
+
+func (c chunkSeq[T]) TakeWhile(pred Predicate[Slice[T]]) (Slice[Slice[T]], Seq[Slice[T]]) {
+	return takeWhileFirst[Slice[T]](c, pred)
+}
+
+func (c chunkSeq[T]) Skip(n int) Seq[Slice[T]] {
+	if n == 0 {
+		return c
+	}
+	return chunkSeq[T]{
+		seq: c.seq.Skip(n * c.n),
+		n:   c.n,
+	}
+}
+
+func (c chunkSeq[T]) Where(pred Predicate[Slice[T]]) Seq[Slice[T]] {
+	return whereSeq[Slice[T]]{
+		seq:  c,
+		pred: pred,
+	}
+}
+
+func (c chunkSeq[T]) While(pred Predicate[Slice[T]]) Seq[Slice[T]] {
+	return whileSeq[Slice[T]]{
+		seq:  c,
+		pred: pred,
+	}
+}
+
+func (c chunkSeq[T]) First() (opt.Opt[Slice[T]], Seq[Slice[T]]) {
+	head, tail := c.seq.Take(c.n)
+	if len(head) == 0 {
+		err := tailError(tail)
+		return opt.ErrorOf[Slice[T]](err), ErrorOf[Slice[T]](err)
+	}
+
+	// If head is shorter than c.n, tail is empty or an error,
+	// and we return it on the next call to First.
+	// The head may share memory with the input, like a sub-slice of a Slice,
+	// so we copy it since the caller owns the returned chunk.
+	return opt.Of(slices.Clone(head)), chunkSeq[T]{
+		seq: tail,
+		n:   c.n,
+	}
+}
+
+func (c chunkSeq[T]) Map(m FuncMap[Slice[T], Slice[T]]) Seq[Slice[T]] {
+	return mappedSeq[Slice[T], Slice[T]]{
+		f:   m,
+		seq: c,
+	}
+}
+
+type windowSeq[T any] struct {
+	seq     Seq[T]
+	size    int
+	step    int
+	overlap Slice[T] // elements from the previous window, that are also in the next
+	skip    int      // elements to skip before the next window, when step > size
+}
+
+// WindowOf returns a Seq of windows over the input seq, each window holding size elements.
+// A new window is started for every step elements in the input.
+// If step is less than size you get sliding windows that overlap,
+// and if step is equal to size you get tumbling windows that do not overlap.
+// If step is greater than size some elements will not be included in any window.
+//
+// Only complete windows are returned, so if the input seq has fewer than size elements
+// the window seq is empty. If you need the last partial window, use ChunkOf.
+//
+// Like SplitOf, the windowing is "semi-lazy": Each window is read eagerly into a Slice,
+// but the tail is not executed. If the length of the input seq is well-defined,
+// so is the length of the window seq.
+//
+// Example, computing a rolling average of 3 elements:
+//
+//	avgs := seq.MappingOf(seq.WindowOf(nums, 3, 1), func(w seq.Slice[float64]) float64 {
+//	    return fnmath.Sum(w) / 3
+//	})
+func WindowOf[T any](seq Seq[T], size, step int) Seq[Slice[T]] {
+	if size <= 0 {
+		panic("window size must be > 0")
+	} else if step <= 0 {
+		panic("window step must be > 0")
+	}
+	return windowSeq[T]{
+		seq:  seq,
+		size: size,
+		step: step,
+	}
+}
+
+func (w windowSeq[T]) ForEach(f Func1[Slice[T]]) opt.Opt[Slice[T]] {
+	var (
+		fst  opt.Opt[Slice[T]]
+		tail Seq[Slice[T]]
+	)
+	for fst, tail = w.First(); fst.Ok(); fst, tail = tail.First() {
+		f(fst.Must())
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (w windowSeq[T]) ForEachIndex(f Func2[int, Slice[T]]) opt.Opt[Slice[T]] {
+	var (
+		fst  opt.Opt[Slice[T]]
+		tail Seq[Slice[T]]
+		i    = 0
+	)
+	for fst, tail = w.First(); fst.Ok(); fst, tail = tail.First() {
+		f(i, fst.Must())
+		i++
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (w windowSeq[T]) Len() (int, bool) {
+	sz, ok := w.seq.Len()
+	if !ok {
+		return sz, false
+	}
+
+	// Number of input elements available to the next window
+	sz = sz + len(w.overlap) - w.skip
+	if sz < w.size {
+		return 0, true
+	}
+	return (sz-w.size)/w.step + 1, true
+}
+
+func (w windowSeq[T]) ToSlice() Slice[Slice[T]] {
+	var arr []Slice[T]
+	if sz, ok := w.Len(); ok {
+		arr = make([]Slice[T], 0, sz)
+	}
+	w.ForEach(func(window Slice[T]) {
+		arr = append(arr, window)
+	})
+	return arr
+}
+
+func (w windowSeq[T]) Limit(n int) Seq[Slice[T]] {
+	return LimitOf[Slice[T]](w, n)
+}
+
+func (w windowSeq[T]) Take(n int) (Slice[Slice[T]], Seq[Slice[T]]) {
+	return takeFirst[Slice[T]](w, n)
+}
+
+func (w windowSeq[T]) TakeWhile(pred Predicate[Slice[T]]) (Slice[Slice[T]], Seq[Slice[T]]) {
+	return takeWhileFirst[Slice[T]](w, pred)
+}
+
+func (w windowSeq[T]) Skip(n int) Seq[Slice[T]] {
+	return skipFirst[Slice[T]](w, n)
+}
+
+func (w windowSeq[T]) Where(pred Predicate[Slice[T]]) Seq[Slice[T]] {
+	return whereSeq[Slice[T]]{
+		seq:  w,
+		pred: pred,
+	}
+}
+
+func (w windowSeq[T]) While(pred Predicate[Slice[T]]) Seq[Slice[T]] {
+	return whileSeq[Slice[T]]{
+		seq:  w,
+		pred: pred,
+	}
+}
+
+func (w windowSeq[T]) First() (opt.Opt[Slice[T]], Seq[Slice[T]]) {
+	tail := w.seq
+	if w.skip > 0 {
+		tail = tail.Skip(w.skip)
+	}
+
+	head, tail := tail.Take(w.size - len(w.overlap))
+	if len(w.overlap)+len(head) < w.size {
+		// Not enough elements for a complete window
+		err := tailError(tail)
+		return opt.ErrorOf[Slice[T]](err), ErrorOf[Slice[T]](err)
+	}
+
+	window := make(Slice[T], 0, w.size)
+	window = append(window, w.overlap...)
+	window = append(window, head...)
+
+	next := windowSeq[T]{
+		seq:  tail,
+		size: w.size,
+		step: w.step,
+	}
+	if w.step < w.size {
+		// Copy the overlap, since the caller owns the returned window and may modify it
+		next.overlap = slices.Clone(window[w.step:])
+	} else {
+		next.skip = w.step - w.size
+	}
+
+	return opt.Of(window), next
+}
+
+func (w windowSeq[T]) Map(m FuncMap[Slice[T], Slice[T]]) Seq[Slice[T]] {
+	return mappedSeq[Slice[T], Slice[T]]{
+		f:   m,
+		seq: w,
+	}
+}
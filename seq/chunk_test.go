@@ -0,0 +1,136 @@
+package seq_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func sliceEqual[T any](s1, s2 seq.Slice[T]) bool {
+	return reflect.DeepEqual(s1, s2)
+}
+
+func lenIs[T any](t *testing.T, sq seq.Seq[T], n int) {
+	t.Helper()
+	if sz, _ := sq.Len(); sz != n {
+		t.Errorf("Seq len mismatch. Expected %d, found %d", n, sz)
+	}
+}
+
+func TestChunkSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Slice[int]] {
+		return seq.ChunkOf(seq.RangeOf(0, 7), 3)
+	}
+	fntesting.SuiteOf(t, createSeq).
+		WithEqual(sliceEqual[int]).
+		Is(seq.SliceAsArgs(0, 1, 2), seq.SliceAsArgs(3, 4, 5), seq.SliceAsArgs(6))
+}
+
+func TestChunkEmptySuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Slice[int]] {
+		return seq.ChunkOf(seq.Empty[int](), 3)
+	}
+	fntesting.SuiteOf(t, createSeq).IsEmpty()
+}
+
+func TestChunkLen(t *testing.T) {
+	lenIs(t, seq.ChunkOf(seq.RangeOf(0, 6), 3).Skip(1), 1)
+	lenIs(t, seq.ChunkOf(seq.RangeOf(0, 7), 3), 3)
+	lenIs(t, seq.ChunkOf(seq.Constant(1), 3), seq.LenInfinite)
+}
+
+func TestChunkError(t *testing.T) {
+	theError := errors.New("the error")
+	chunks := seq.ChunkOf(errorAfter(theError, 1, 2, 3, 4), 3)
+
+	var arr []seq.Slice[int]
+	res := chunks.ForEach(func(chunk seq.Slice[int]) {
+		arr = append(arr, chunk)
+	})
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+	if !reflect.DeepEqual(arr, []seq.Slice[int]{{1, 2, 3}, {4}}) {
+		t.Fatalf("unexpected chunks: %v", arr)
+	}
+}
+
+func TestWindowSlidingSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Slice[int]] {
+		return seq.WindowOf(seq.RangeOf(0, 5), 3, 1)
+	}
+	fntesting.SuiteOf(t, createSeq).
+		WithEqual(sliceEqual[int]).
+		Is(seq.SliceAsArgs(0, 1, 2), seq.SliceAsArgs(1, 2, 3), seq.SliceAsArgs(2, 3, 4))
+}
+
+func TestWindowTumblingSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Slice[int]] {
+		return seq.WindowOf(seq.RangeOf(0, 7), 2, 2)
+	}
+	fntesting.SuiteOf(t, createSeq).
+		WithEqual(sliceEqual[int]).
+		Is(seq.SliceAsArgs(0, 1), seq.SliceAsArgs(2, 3), seq.SliceAsArgs(4, 5))
+}
+
+func TestWindowHoppingSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Slice[int]] {
+		return seq.WindowOf(seq.RangeOf(0, 8), 2, 3)
+	}
+	fntesting.SuiteOf(t, createSeq).
+		WithEqual(sliceEqual[int]).
+		Is(seq.SliceAsArgs(0, 1), seq.SliceAsArgs(3, 4), seq.SliceAsArgs(6, 7))
+}
+
+func TestWindowShortSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Slice[int]] {
+		return seq.WindowOf(seq.RangeOf(0, 2), 3, 1)
+	}
+	fntesting.SuiteOf(t, createSeq).IsEmpty()
+}
+
+func TestWindowLen(t *testing.T) {
+	windows := seq.WindowOf(seq.RangeOf(0, 10), 4, 2)
+	lenIs(t, windows, 4)
+
+	_, tail := windows.First()
+	lenIs(t, tail, 3)
+
+	windows = seq.WindowOf(seq.RangeOf(0, 10), 2, 3)
+	lenIs(t, windows, 3)
+
+	_, tail = windows.First()
+	lenIs(t, tail, 2)
+}
+
+func TestWindowModifyWindow(t *testing.T) {
+	fst, tail := seq.WindowOf(seq.RangeOf(0, 4), 3, 1).First()
+	window := fst.Must()
+	for i := range window {
+		window[i] = -1
+	}
+
+	fst, _ = tail.First()
+	if !reflect.DeepEqual(fst.Must(), seq.SliceAsArgs(1, 2, 3)) {
+		t.Fatalf("modifying a window must not change the next window, got: %v", fst.Must())
+	}
+}
+
+func TestChunkModifyChunk(t *testing.T) {
+	src := seq.SliceAsArgs(1, 2, 3, 4, 5)
+	chunks := seq.ChunkOf(src, 2).ToSlice()
+	_ = append(chunks[0], 99)
+	chunks[1][0] = -1
+
+	if !reflect.DeepEqual(src, seq.SliceAsArgs(1, 2, 3, 4, 5)) {
+		t.Fatalf("modifying a chunk must not change the input, got: %v", src)
+	}
+}
+
+// errorAfter returns a seq with the elements ts, followed by an error.
+func errorAfter[T any](err error, ts ...T) seq.Seq[T] {
+	return seq.ConcatOf(seq.SliceOf(ts), seq.ErrorOf[T](err))
+}
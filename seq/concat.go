@@ -28,18 +28,21 @@ func ConcatOf[T any](seqs ...Seq[T]) Seq[T] {
 func (c concatSeq[T]) ForEach(f Func1[T]) opt.Opt[T] {
 	var res opt.Opt[T]
 	if c.head != nil {
-		res = c.ForEach(f)
+		res = c.head.ForEach(f)
 	}
 	if res.Empty() {
 		return res
 	}
 
 	if c.tail != nil {
-		c.tail.ForEach(func(seq Seq[T]) {
+		tailRes := c.tail.ForEach(func(seq Seq[T]) {
 			if res.Ok() {
 				res = seq.ForEach(f)
 			}
 		})
+		if err := tailRes.Error(); err != nil && res.Ok() {
+			return opt.ErrorOf[T](err)
+		}
 	}
 
 	return res
@@ -112,6 +115,9 @@ func (c concatSeq[T]) Take(n int) (Slice[T], Seq[T]) {
 				tail: c.tail,
 			}
 		}
+		if err := tailError(headTail); err != opt.ErrEmpty {
+			return arr, ErrorOf[T](err)
+		}
 		c.head = nil // head depleted (it returned < n)
 	}
 
@@ -138,7 +144,10 @@ func (c concatSeq[T]) Take(n int) (Slice[T], Seq[T]) {
 			return arr, c
 		}
 
-		// c.head depleted, go again
+		// c.head depleted, go again, unless it ended with an error
+		if err := tailError(headTail); err != opt.ErrEmpty {
+			return arr, ErrorOf[T](err)
+		}
 		c.head = nil
 	}
 }
@@ -29,8 +29,57 @@ func TestConcatError(t *testing.T) {
 	}
 }
 
+func TestConcatTailForEach(t *testing.T) {
+	// The tail has a head seq, which ForEach must execute instead of recursing into itself
+	_, tail := seq.ConcatOf(seq.SliceOfArgs(1, 2), seq.SliceOfArgs(3)).First()
+	fntesting.TestOf(t, tail).Is(2, 3)
+}
+
+func TestFlattenError(t *testing.T) {
+	// Errors from the seq of seqs must be returned from ForEach
+	theError := errors.New("the error")
+	seqs := seq.ConcatOf(seq.SliceOfArgs(seq.SliceOfArgs(1, 2)), seq.ErrorOf[seq.Seq[int]](theError))
+
+	res := seq.FlattenOf(seqs).ForEach(func(_ int) {})
+	if err := res.Error(); err != theError {
+		t.Fatalf("Expected 'the error', found: %s", err)
+	}
+}
+
+func TestConcatTakeError(t *testing.T) {
+	theError := errors.New("the error")
+	cc := seq.ConcatOf(seq.SliceOfArgs(1, 2), seq.ErrorOf[int](theError), seq.SliceOfArgs(3))
+
+	head, tail := cc.Take(5)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2)
+
+	fst, _ := tail.First()
+	if err := fst.Error(); err != theError {
+		t.Fatalf("Expected 'the error' from tail, found: %s", err)
+	}
+
+	// Also when the error is in a seq after the first one
+	cc = seq.ConcatOf(seq.SliceOfArgs(1), seq.PrependOf(2, seq.ErrorOf[int](theError)), seq.SliceOfArgs(3))
+	head, tail = cc.Take(5)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2)
+	if fst, _ = tail.First(); fst.Error() != theError {
+		t.Fatalf("Expected 'the error' from tail, found: %s", fst.Error())
+	}
+}
+
 func TestConcatWithEmpty(t *testing.T) {
 	fntesting.SuiteOf(t, func() seq.Seq[int] {
 		return seq.ConcatOf(seq.Empty[int](), seq.SingletOf(1), seq.SliceOfArgs(2, 3))
 	}).Is(1, 2, 3)
 }
+
+func TestPrependTake(t *testing.T) {
+	// Take must not consume an extra element from the tail
+	head, tail := seq.PrependOf(1, seq.SliceOfArgs(2, 3, 4).Where(seq.GreaterThanZero[int])).Take(2)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2)
+	fntesting.TestOf(t, tail).Is(3, 4)
+
+	head, tail = seq.PrependOf(1, seq.SliceOfArgs(2)).Take(3)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2)
+	fntesting.TestOf(t, tail).Is()
+}
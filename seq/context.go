@@ -0,0 +1,140 @@
+package seq
+
+import (
+	"context"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+type contextSeq[T any] struct {
+	ctx context.Context
+	seq Seq[T]
+}
+
+// ContextOf binds a context.Context to a Seq. When the context is done, the returned seq
+// stops executing and methods like ForEach, Take, TakeWhile, and First return ctx.Err()
+// via their opt or tail seq. Since Reduce is executed via ForEach, it will also stop
+// and return an opt with the context error.
+//
+// The context is checked before each element is executed. This means that a seq blocking
+// on a single element, like a Chan waiting for a value, will not be interrupted until that
+// element is ready.
+//
+// If the seq is passed to Go, the context is also used to stop feeding elements to the
+// worker goroutines.
+//
+// Example, aborting a long running pipeline when an HTTP request is cancelled:
+//
+//	lines := seq.ContextOf(req.Context(), seqio.LinesOf(body))
+//	res := seq.Reduce(seq.Count[[]byte], 0, lines)
+//	if err := res.Error(); err != nil {
+//	    // err may be context.Canceled
+//	}
+func ContextOf[T any](ctx context.Context, seq Seq[T]) Seq[T] {
+	return contextSeq[T]{
+		ctx: ctx,
+		seq: seq,
+	}
+}
+
+func (c contextSeq[T]) ForEach(f Func1[T]) opt.Opt[T] {
+	var (
+		fst  opt.Opt[T]
+		tail Seq[T]
+	)
+	for fst, tail = c.First(); fst.Ok(); fst, tail = tail.First() {
+		f(fst.Must())
+	}
+
+	return zeroIfEmpty(fst)
+}
+
+func (c contextSeq[T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	var (
+		fst  opt.Opt[T]
+		tail Seq[T]
+		i    int
+	)
+	for fst, tail = c.First(); fst.Ok(); fst, tail = tail.First() {
+		f(i, fst.Must())
+		i++
+	}
+
+	return zeroIfEmpty(fst)
+}
+
+func (c contextSeq[T]) Len() (int, bool) {
+	// The context can end the seq at any point, so we only know the length if it is empty
+	if sz, _ := c.seq.Len(); sz == 0 {
+		return 0, true
+	}
+	return LenUnknown, false
+}
+
+func (c contextSeq[T]) ToSlice() Slice[T] {
+	var arr []T
+	c.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (c contextSeq[T]) Limit(n int) Seq[T] {
+	return LimitOf[T](c, n)
+}
+
+func (c contextSeq[T]) Take(n int) (Slice[T], Seq[T]) {
+	return takeFirst[T](c, n)
+}
+
+func (c contextSeq[T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	return takeWhileFirst[T](c, pred)
+}
+
+func (c contextSeq[T]) Skip(n int) Seq[T] {
+	return skipFirst[T](c, n)
+}
+
+func (c contextSeq[T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  c,
+		pred: pred,
+	}
+}
+
+func (c contextSeq[T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  c,
+		pred: pred,
+	}
+}
+
+func (c contextSeq[T]) First() (opt.Opt[T], Seq[T]) {
+	if err := c.ctx.Err(); err != nil {
+		return opt.ErrorOf[T](err), ErrorOf[T](err)
+	}
+
+	fst, tail := c.seq.First()
+	if err := fst.Error(); err != nil {
+		return fst, ErrorOf[T](err)
+	}
+	return fst, contextSeq[T]{
+		ctx: c.ctx,
+		seq: tail,
+	}
+}
+
+func (c contextSeq[T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: c,
+	}
+}
+
+// contextFrom returns the context bound to a seq via ContextOf, or context.Background().
+func contextFrom[T any](seq Seq[T]) context.Context {
+	if c, ok := seq.(contextSeq[T]); ok {
+		return c.ctx
+	}
+	return context.Background()
+}
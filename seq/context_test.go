@@ -0,0 +1,95 @@
+package seq_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func TestContextSuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.ContextOf(context.Background(), seq.SliceOfArgs(1, 2, 3, 4))
+	}
+	fntesting.SuiteOf(t, createSeq).Is(1, 2, 3, 4)
+}
+
+func TestContextCancelForEach(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var arr []int
+	res := seq.ContextOf(ctx, seq.RangeFrom(0)).ForEach(func(i int) {
+		arr = append(arr, i)
+		if i == 2 {
+			cancel()
+		}
+	})
+
+	if res.Error() != context.Canceled {
+		t.Fatalf("expected context.Canceled, got: %v", res.Error())
+	}
+	if !reflect.DeepEqual(arr, []int{0, 1, 2}) {
+		t.Fatalf("unexpected result: %v", arr)
+	}
+}
+
+func TestContextCancelReduce(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ints := seq.ContextOf(ctx, seq.Constant(1)).
+		Map(func(i int) int {
+			cancel()
+			return i
+		})
+	res := seq.Reduce(seq.Count[int], 0, ints)
+	if res.Error() != context.Canceled {
+		t.Fatalf("expected context.Canceled, got: %v", res.Error())
+	}
+}
+
+func TestContextCancelTake(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ints := seq.ContextOf(ctx, seq.RangeFrom(0))
+	head, tail := ints.Take(2)
+	if !reflect.DeepEqual(head, seq.SliceAsArgs(0, 1)) {
+		t.Fatalf("unexpected head: %v", head)
+	}
+
+	cancel()
+	head, tail = tail.TakeWhile(seq.LessThan(10))
+	if len(head) != 0 {
+		t.Fatalf("expected empty head: %v", head)
+	}
+
+	fst, _ := tail.First()
+	if fst.Error() != context.Canceled {
+		t.Fatalf("expected context.Canceled, got: %v", fst.Error())
+	}
+}
+
+func TestContextGo(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ints := seq.ContextOf(ctx, seq.RangeOf(0, 1000))
+	res := seq.Go(ints, 3, func(i int) int {
+		return i
+	})
+
+	count := 0
+	tail := res.ForEach(func(_ int) {
+		count++
+	})
+	if tail.Error() != context.Canceled {
+		t.Fatalf("expected context.Canceled, got: %v", tail.Error())
+	}
+	if count != 0 {
+		t.Fatalf("expected no results, got %d", count)
+	}
+}
@@ -0,0 +1,309 @@
+package seq
+
+import (
+	"sync"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+type distinctSeq[T any, K comparable] struct {
+	seq Seq[T]
+	key FuncMap[T, K]
+	// seen is shared by the seq and all its tails. Since it records the index of the first
+	// occurrence of each key, and entries never change once recorded, a tail can be executed
+	// any number of times, also from several goroutines.
+	seen *seenKeys[K]
+	pos  int // index in the source seq of the first element in seq
+}
+
+// seenKeys maps keys to the index of their first occurrence in the source seq of a Distinct.
+type seenKeys[K comparable] struct {
+	mu    sync.Mutex
+	first map[K]int
+}
+
+// Distinct returns a lazy seq with the first occurrence of each element in the input seq.
+// Elements are kept track of in a set, so memory usage grows with the number of unique elements.
+// If the input is sorted, or you only want to remove consecutive duplicates,
+// DistinctAdjacent uses O(1) memory.
+//
+// For a []T there is also slice.Uniq.
+func Distinct[T comparable](seq Seq[T]) Seq[T] {
+	return DistinctBy(seq, func(t T) T { return t })
+}
+
+// DistinctBy returns a lazy seq with the first element for each key, as returned by the key function.
+// Keys are kept track of in a set, so memory usage grows with the number of unique keys.
+//
+// Example, keeping the first user with each email:
+//
+//	uniqueUsers := seq.DistinctBy(users, func(u User) string { return u.Email })
+func DistinctBy[T any, K comparable](seq Seq[T], key FuncMap[T, K]) Seq[T] {
+	return distinctSeq[T, K]{
+		seq:  seq,
+		key:  key,
+		seen: &seenKeys[K]{first: make(map[K]int)},
+	}
+}
+
+func (d distinctSeq[T, K]) ForEach(f Func1[T]) opt.Opt[T] {
+	i := d.pos
+	return d.seq.ForEach(func(t T) {
+		if d.seen.isFirst(d.key(t), i) {
+			f(t)
+		}
+		i++
+	})
+}
+
+func (d distinctSeq[T, K]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	i, j := d.pos, 0
+	return d.seq.ForEach(func(t T) {
+		if d.seen.isFirst(d.key(t), i) {
+			f(j, t)
+			j++
+		}
+		i++
+	})
+}
+
+func (d distinctSeq[T, K]) Len() (int, bool) {
+	if sz, _ := d.seq.Len(); sz == 0 {
+		return 0, true
+	}
+	return LenUnknown, false
+}
+
+func (d distinctSeq[T, K]) ToSlice() Slice[T] {
+	var arr []T
+	d.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (d distinctSeq[T, K]) Limit(n int) Seq[T] {
+	return LimitOf[T](d, n)
+}
+
+func (d distinctSeq[T, K]) Take(n int) (Slice[T], Seq[T]) {
+	if n == 0 {
+		return []T{}, d
+	}
+
+	var arr []T
+	tail := d.takeWhile(func(t T) bool {
+		if len(arr) == n {
+			return false
+		}
+		arr = append(arr, t)
+		return true
+	})
+	return arr, tail
+}
+
+func (d distinctSeq[T, K]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	var arr []T
+	tail := d.takeWhile(func(t T) bool {
+		if pred(t) {
+			arr = append(arr, t)
+			return true
+		}
+		return false
+	})
+	return arr, tail
+}
+
+func (d distinctSeq[T, K]) Skip(n int) Seq[T] {
+	if n == 0 {
+		return d
+	}
+
+	i := 0
+	tail := d.takeWhile(func(t T) bool {
+		i++
+		return i <= n
+	})
+	return tail
+}
+
+func (d distinctSeq[T, K]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  d,
+		pred: pred,
+	}
+}
+
+func (d distinctSeq[T, K]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  d,
+		pred: pred,
+	}
+}
+
+func (d distinctSeq[T, K]) First() (opt.Opt[T], Seq[T]) {
+	var (
+		fst  opt.Opt[T]
+		tail Seq[T]
+	)
+	for i := d.pos; ; i++ {
+		fst, tail = d.seq.First()
+		val, err := fst.Return()
+		if err != nil {
+			return fst, ErrorOf[T](err)
+		}
+		if d.seen.isFirst(d.key(val), i) {
+			return fst, distinctSeq[T, K]{
+				seq:  tail,
+				key:  d.key,
+				seen: d.seen,
+				pos:  i + 1,
+			}
+		}
+		d.seq = tail
+	}
+}
+
+func (d distinctSeq[T, K]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: d,
+	}
+}
+
+// takeWhile calls pred on distinct elements until it returns false, and returns the tail.
+// Collecting the elements is left to pred.
+func (d distinctSeq[T, K]) takeWhile(pred Predicate[T]) Seq[T] {
+	i := d.pos
+	_, tail := d.seq.TakeWhile(func(t T) bool {
+		// Note: it is fine to record t as seen even if pred(t) is false,
+		// because it is recorded under its own index and will be yielded again from the tail.
+		if d.seen.isFirst(d.key(t), i) && !pred(t) {
+			return false
+		}
+		i++
+		return true
+	})
+	return distinctSeq[T, K]{
+		seq:  tail,
+		key:  d.key,
+		seen: d.seen,
+		pos:  i,
+	}
+}
+
+// isFirst returns true if idx is the index of the first occurrence of k.
+func (s *seenKeys[K]) isFirst(k K, idx int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if first, ok := s.first[k]; ok {
+		return first == idx
+	}
+	s.first[k] = idx
+	return true
+}
+
+type distinctAdjacentSeq[T comparable] struct {
+	seq     Seq[T]
+	prev    T
+	hasPrev bool
+}
+
+// DistinctAdjacent returns a lazy seq where consecutive duplicate elements are collapsed into one.
+// If the input seq is sorted, the result only contains unique elements.
+// Unlike Distinct, DistinctAdjacent uses O(1) memory.
+func DistinctAdjacent[T comparable](seq Seq[T]) Seq[T] {
+	return distinctAdjacentSeq[T]{seq: seq}
+}
+
+func (d distinctAdjacentSeq[T]) ForEach(f Func1[T]) opt.Opt[T] {
+	prev, hasPrev := d.prev, d.hasPrev
+	return d.seq.ForEach(func(t T) {
+		if !hasPrev || t != prev {
+			f(t)
+		}
+		prev, hasPrev = t, true
+	})
+}
+
+func (d distinctAdjacentSeq[T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	i := 0
+	return d.ForEach(func(t T) {
+		f(i, t)
+		i++
+	})
+}
+
+func (d distinctAdjacentSeq[T]) Len() (int, bool) {
+	if sz, _ := d.seq.Len(); sz == 0 {
+		return 0, true
+	}
+	return LenUnknown, false
+}
+
+func (d distinctAdjacentSeq[T]) ToSlice() Slice[T] {
+	var arr []T
+	d.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (d distinctAdjacentSeq[T]) Limit(n int) Seq[T] {
+	return LimitOf[T](d, n)
+}
+
+func (d distinctAdjacentSeq[T]) Take(n int) (Slice[T], Seq[T]) {
+	return takeFirst[T](d, n)
+}
+
+func (d distinctAdjacentSeq[T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	return takeWhileFirst[T](d, pred)
+}
+
+func (d distinctAdjacentSeq[T]) Skip(n int) Seq[T] {
+	return skipFirst[T](d, n)
+}
+
+func (d distinctAdjacentSeq[T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  d,
+		pred: pred,
+	}
+}
+
+func (d distinctAdjacentSeq[T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  d,
+		pred: pred,
+	}
+}
+
+func (d distinctAdjacentSeq[T]) First() (opt.Opt[T], Seq[T]) {
+	var (
+		fst  opt.Opt[T]
+		tail = d.seq
+	)
+	for {
+		fst, tail = tail.First()
+		val, err := fst.Return()
+		if err != nil {
+			return fst, ErrorOf[T](err)
+		}
+		if !d.hasPrev || val != d.prev {
+			return fst, distinctAdjacentSeq[T]{
+				seq:     tail,
+				prev:    val,
+				hasPrev: true,
+			}
+		}
+	}
+}
+
+func (d distinctAdjacentSeq[T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: d,
+	}
+}
@@ -0,0 +1,99 @@
+package seq_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func TestDistinctSuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.Distinct(seq.SliceOfArgs(1, 2, 1, 3, 2, 2, 4, 1))
+	}
+	fntesting.SuiteOf(t, createSeq).Is(1, 2, 3, 4)
+}
+
+func TestDistinctEmptySuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.Distinct(seq.Empty[int]())
+	}
+	fntesting.SuiteOf(t, createSeq).IsEmpty()
+}
+
+func TestDistinctBySuite(t *testing.T) {
+	createSeq := func() seq.Seq[string] {
+		return seq.DistinctBy(seq.SliceOfArgs("Bob", "alan", "bob", "Alan", "scotty"), strings.ToLower)
+	}
+	fntesting.SuiteOf(t, createSeq).Is("Bob", "alan", "scotty")
+}
+
+func TestDistinctTail(t *testing.T) {
+	ints := seq.Distinct(seq.SliceOfArgs(1, 2, 1, 3, 2, 4))
+	head, tail := ints.Take(2)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2)
+
+	// tails must remember the elements already seen, and be immutable
+	fntesting.TestOf(t, tail).Is(3, 4)
+	fntesting.TestOf(t, tail).Is(3, 4)
+
+	fst, tail := ints.First()
+	fntesting.OptOf(t, fst).Is(1)
+	fntesting.TestOf(t, tail).Is(2, 3, 4)
+	fntesting.TestOf(t, tail.Skip(1)).Is(3, 4)
+}
+
+func TestDistinctInfinite(t *testing.T) {
+	ints := seq.Distinct(seq.MappingOf(seq.RangeFrom(0), func(i int) int { return i / 3 }))
+	head, _ := ints.Take(3)
+	fntesting.TestOf(t, head.Seq()).Is(0, 1, 2)
+}
+
+func TestDistinctAdjacentSuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.DistinctAdjacent(seq.SliceOfArgs(1, 1, 2, 3, 3, 3, 1, 4, 4))
+	}
+	fntesting.SuiteOf(t, createSeq).Is(1, 2, 3, 1, 4)
+}
+
+func TestDistinctAdjacentTail(t *testing.T) {
+	fst, tail := seq.DistinctAdjacent(seq.SliceOfArgs(1, 1, 1, 2, 2)).First()
+	fntesting.OptOf(t, fst).Is(1)
+	fntesting.TestOf(t, tail).Is(2)
+}
+
+func TestDistinctTailReexecute(t *testing.T) {
+	_, tail := seq.Distinct(seq.SliceOfArgs(1, 2, 1, 3, 2, 4)).First()
+
+	// Executing the tail must not change it, also when done concurrently
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tail.ToSlice()
+			tail.First()
+		}()
+	}
+	wg.Wait()
+
+	fntesting.TestOf(t, tail).Is(2, 3, 4)
+	fntesting.TestOf(t, tail).Is(2, 3, 4)
+}
+
+func TestDistinctLongSeq(t *testing.T) {
+	// Stepping through the seq with First must not copy the seen keys for each element
+	const n = 100_000
+	sq := seq.Distinct(seq.ConcatOf(seq.RangeOf(0, n), seq.RangeOf(0, n)))
+	count := 0
+	for range seq.Iter(sq) {
+		count++
+	}
+	if count != n {
+		t.Fatalf("expected %d distinct elements, got %d", n, count)
+	}
+
+	fntesting.TestOf(t, sq.Skip(n-2).Limit(5)).Is(n-2, n-1)
+}
@@ -0,0 +1,300 @@
+package seq
+
+import "github.com/kamstrup/fn/opt"
+
+type flatMapSeq[S, T any] struct {
+	seq Seq[S]
+	f   FuncMap[S, Seq[T]]
+	cur Seq[T] // the remainder of the current inner seq, or nil
+}
+
+// FlatMapOf creates a new Seq that lazily converts each value, via a FuncMap, into a Seq,
+// and steps through the elements of these seqs as though it was one big seq.
+// It works like FlattenOf(MappingOf(seq, f)), but without the double wrapping.
+// The tails returned from the Seq methods can stop in the middle of an inner seq.
+//
+// If the input seq, or any of the inner seqs, fail the returned seq stops with the error.
+//
+// Example, reading all lines from a list of files:
+//
+//	lines := seq.FlatMapOf(files, func(f *os.File) seq.Seq[string] {
+//	    return seqio.LinesOf(f)
+//	})
+func FlatMapOf[S, T any](seq Seq[S], f FuncMap[S, Seq[T]]) Seq[T] {
+	return flatMapSeq[S, T]{
+		seq: seq,
+		f:   f,
+	}
+}
+
+func (fm flatMapSeq[S, T]) ForEach(f Func1[T]) opt.Opt[T] {
+	if fm.cur != nil {
+		if res := fm.cur.ForEach(f); res.Error() != nil {
+			return res
+		}
+	}
+
+	var (
+		fst  opt.Opt[S]
+		tail = fm.seq
+	)
+	for fst, tail = tail.First(); fst.Ok(); fst, tail = tail.First() {
+		if res := fm.f(fst.Must()).ForEach(f); res.Error() != nil {
+			return res
+		}
+	}
+
+	if err := fst.Error(); err != opt.ErrEmpty {
+		return opt.ErrorOf[T](err)
+	}
+	return opt.Zero[T]()
+}
+
+func (fm flatMapSeq[S, T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	i := 0
+	return fm.ForEach(func(t T) {
+		f(i, t)
+		i++
+	})
+}
+
+func (fm flatMapSeq[S, T]) Len() (int, bool) {
+	if sz, _ := fm.seq.Len(); sz == 0 {
+		if fm.cur == nil {
+			return 0, true
+		}
+		return fm.cur.Len()
+	}
+	return LenUnknown, false
+}
+
+func (fm flatMapSeq[S, T]) ToSlice() Slice[T] {
+	var arr []T
+	fm.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (fm flatMapSeq[S, T]) Limit(n int) Seq[T] {
+	return LimitOf[T](fm, n)
+}
+
+func (fm flatMapSeq[S, T]) Take(n int) (Slice[T], Seq[T]) {
+	return takeFirst[T](fm, n)
+}
+
+func (fm flatMapSeq[S, T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	return takeWhileFirst[T](fm, pred)
+}
+
+func (fm flatMapSeq[S, T]) Skip(n int) Seq[T] {
+	return skipFirst[T](fm, n)
+}
+
+func (fm flatMapSeq[S, T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  fm,
+		pred: pred,
+	}
+}
+
+func (fm flatMapSeq[S, T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  fm,
+		pred: pred,
+	}
+}
+
+func (fm flatMapSeq[S, T]) First() (opt.Opt[T], Seq[T]) {
+	for {
+		if fm.cur != nil {
+			fst, tail := fm.cur.First()
+			if fst.Ok() {
+				return fst, flatMapSeq[S, T]{
+					seq: fm.seq,
+					f:   fm.f,
+					cur: tail,
+				}
+			} else if err := fst.Error(); err != opt.ErrEmpty {
+				return fst, ErrorOf[T](err)
+			}
+		}
+
+		// The current inner seq is exhausted, move on to the next
+		fst, tail := fm.seq.First()
+		s, err := fst.Return()
+		if err != nil {
+			return opt.ErrorOf[T](err), ErrorOf[T](err)
+		}
+		fm.seq, fm.cur = tail, fm.f(s)
+	}
+}
+
+func (fm flatMapSeq[S, T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: fm,
+	}
+}
+
+type flatMapSliceSeq[S, T any] struct {
+	seq Seq[S]
+	f   func(S) []T
+	cur []T // the remainder of the current slice
+}
+
+// FlatMapSliceOf is like FlatMapOf, but the function returns a slice instead of a Seq.
+// This avoids wrapping each slice in a Seq, and the returned seq can not fail from
+// the slices, only from the input seq.
+//
+// Example, splitting lines into words:
+//
+//	words := seq.FlatMapSliceOf(lines, strings.Fields)
+func FlatMapSliceOf[S, T any](seq Seq[S], f func(S) []T) Seq[T] {
+	return flatMapSliceSeq[S, T]{
+		seq: seq,
+		f:   f,
+	}
+}
+
+func (fm flatMapSliceSeq[S, T]) ForEach(f Func1[T]) opt.Opt[T] {
+	for _, t := range fm.cur {
+		f(t)
+	}
+
+	res := fm.seq.ForEach(func(s S) {
+		for _, t := range fm.f(s) {
+			f(t)
+		}
+	})
+
+	if err := res.Error(); err != nil {
+		return opt.ErrorOf[T](err)
+	}
+	return opt.Zero[T]()
+}
+
+func (fm flatMapSliceSeq[S, T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	i := 0
+	return fm.ForEach(func(t T) {
+		f(i, t)
+		i++
+	})
+}
+
+func (fm flatMapSliceSeq[S, T]) Len() (int, bool) {
+	if sz, _ := fm.seq.Len(); sz == 0 {
+		return len(fm.cur), true
+	}
+	return LenUnknown, false
+}
+
+func (fm flatMapSliceSeq[S, T]) ToSlice() Slice[T] {
+	var arr []T
+	fm.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (fm flatMapSliceSeq[S, T]) Limit(n int) Seq[T] {
+	return LimitOf[T](fm, n)
+}
+
+func (fm flatMapSliceSeq[S, T]) Take(n int) (Slice[T], Seq[T]) {
+	if n == 0 {
+		return []T{}, fm
+	}
+
+	var arr []T
+	for {
+		if len(fm.cur) >= n-len(arr) {
+			need := n - len(arr)
+			arr = append(arr, fm.cur[:need]...)
+			fm.cur = fm.cur[need:]
+			return arr, fm
+		}
+		arr = append(arr, fm.cur...)
+
+		fst, tail := fm.seq.First()
+		s, err := fst.Return()
+		if err != nil {
+			return arr, ErrorOf[T](err)
+		}
+		fm.seq, fm.cur = tail, fm.f(s)
+	}
+}
+
+func (fm flatMapSliceSeq[S, T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	var arr []T
+	for {
+		for i, t := range fm.cur {
+			if !pred(t) {
+				fm.cur = fm.cur[i:]
+				return arr, fm
+			}
+			arr = append(arr, t)
+		}
+
+		fst, tail := fm.seq.First()
+		s, err := fst.Return()
+		if err != nil {
+			return arr, ErrorOf[T](err)
+		}
+		fm.seq, fm.cur = tail, fm.f(s)
+	}
+}
+
+func (fm flatMapSliceSeq[S, T]) Skip(n int) Seq[T] {
+	for {
+		if len(fm.cur) >= n {
+			fm.cur = fm.cur[n:]
+			return fm
+		}
+		n -= len(fm.cur)
+
+		fst, tail := fm.seq.First()
+		s, err := fst.Return()
+		if err != nil {
+			return ErrorOf[T](err)
+		}
+		fm.seq, fm.cur = tail, fm.f(s)
+	}
+}
+
+func (fm flatMapSliceSeq[S, T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  fm,
+		pred: pred,
+	}
+}
+
+func (fm flatMapSliceSeq[S, T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  fm,
+		pred: pred,
+	}
+}
+
+func (fm flatMapSliceSeq[S, T]) First() (opt.Opt[T], Seq[T]) {
+	for len(fm.cur) == 0 {
+		fst, tail := fm.seq.First()
+		s, err := fst.Return()
+		if err != nil {
+			return opt.ErrorOf[T](err), ErrorOf[T](err)
+		}
+		fm.seq, fm.cur = tail, fm.f(s)
+	}
+
+	t := fm.cur[0]
+	fm.cur = fm.cur[1:]
+	return opt.Of(t), fm
+}
+
+func (fm flatMapSliceSeq[S, T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: fm,
+	}
+}
@@ -0,0 +1,92 @@
+package seq_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func repeatInt(n int) seq.Seq[int] {
+	return seq.RepeatN(seq.SingletOf(n), n)
+}
+
+func TestFlatMapSuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.FlatMapOf(seq.RangeOf(0, 4), repeatInt)
+	}
+	fntesting.SuiteOf(t, createSeq).Is(1, 2, 2, 3, 3, 3)
+}
+
+func TestFlatMapTail(t *testing.T) {
+	sq := seq.FlatMapOf(seq.RangeOf(0, 4), repeatInt)
+	head, tail := sq.Take(2)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2)
+	// The tail starts in the middle of the inner seq for 2
+	fntesting.TestOf(t, tail).Is(2, 3, 3, 3)
+}
+
+func TestFlatMapError(t *testing.T) {
+	theError := errors.New("the error")
+	sq := seq.FlatMapOf(seq.RangeOf(0, 4), func(n int) seq.Seq[int] {
+		if n == 2 {
+			return seq.ConcatOf(seq.SingletOf(n), seq.ErrorOf[int](theError))
+		}
+		return repeatInt(n)
+	})
+
+	var arr []int
+	res := sq.ForEach(func(n int) {
+		arr = append(arr, n)
+	})
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+	fntesting.TestOf(t, seq.SliceOf(arr)).Is(1, 2)
+
+	head, tail := sq.Take(5)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2)
+	fst, _ := tail.First()
+	fntesting.OptOf(t, fst).IsError(theError)
+}
+
+func TestFlatMapSliceSuite(t *testing.T) {
+	createSeq := func() seq.Seq[string] {
+		lines := seq.SliceOfArgs("hello world", "", "how are you")
+		return seq.FlatMapSliceOf(lines, strings.Fields)
+	}
+	fntesting.SuiteOf(t, createSeq).Is("hello", "world", "how", "are", "you")
+}
+
+func TestFlatMapSliceTail(t *testing.T) {
+	lines := seq.SliceOfArgs("a b c", "d e")
+	sq := seq.FlatMapSliceOf(lines, strings.Fields)
+
+	fntesting.TestOf(t, sq.Skip(2)).Is("c", "d", "e")
+
+	head, tail := sq.TakeWhile(func(s string) bool { return s != "b" })
+	fntesting.TestOf(t, head.Seq()).Is("a")
+	fntesting.TestOf(t, tail).Is("b", "c", "d", "e")
+}
+
+func TestFlatMapSliceError(t *testing.T) {
+	theError := errors.New("the error")
+	lines := seq.ConcatOf(seq.SliceOfArgs("a b"), seq.ErrorOf[string](theError))
+	sq := seq.FlatMapSliceOf(lines, strings.Fields)
+
+	var arr []string
+	res := sq.ForEach(func(s string) {
+		arr = append(arr, s)
+	})
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+	fntesting.TestOf(t, seq.SliceOf(arr)).Is("a", "b")
+
+	head, tail := sq.Take(5)
+	fntesting.TestOf(t, head.Seq()).Is("a", "b")
+	fst, _ := tail.First()
+	fntesting.OptOf(t, fst).IsError(theError)
+}
@@ -264,6 +264,14 @@ func TupleWithKey[K comparable, V any](keySelector FuncMap[V, K]) func(V) Tuple[
 	}
 }
 
+// PairWithKey creates a FuncMap to use with MappingOf or Seq.Map.
+// It is like TupleWithKey, but the key is not required to be comparable.
+func PairWithKey[K, V any](keySelector FuncMap[V, K]) func(V) Pair[K, V] {
+	return func(v V) Pair[K, V] {
+		return PairOf(keySelector(v), v)
+	}
+}
+
 // Reduce executes a Seq, collecting the results via a collection function (FuncCollect).
 // The method signature follows append() and copy() conventions,
 // having the destination to put data into first.
@@ -271,7 +279,7 @@ func TupleWithKey[K comparable, V any](keySelector FuncMap[V, K]) func(V) Tuple[
 //
 // This library ships with a suite of standard collector functions.
 // These include MakeSlice, MakeMap, MakeSet, MakeString, MakeBytes, Count,
-// GroupBy, UpdateMap, UpdateSlice, fnmath.Sum, fnmath.Min, fnmath.Max.
+// GroupBy, UpdateMap, UpdateSlice, MakeTopK, GroupTopK, MakeResults, fnmath.Sum, fnmath.Min, fnmath.Max.
 //
 // The second argument, "into", can often be left as nil. It is the initial state for the collector.
 // If you want to pre-allocate or reuse a buffer you can pass it in here. Or if you want to use MakeString
@@ -386,3 +394,67 @@ func IsEmpty[T any](seq Seq[T]) bool {
 	fst, _ := seq.First()
 	return fst.Empty()
 }
+
+// takeFirst implements Seq.Take by repeatedly calling Seq.First.
+func takeFirst[T any](seq Seq[T], n int) (Slice[T], Seq[T]) {
+	if n == 0 {
+		return []T{}, seq
+	}
+
+	var (
+		arr  []T
+		fst  opt.Opt[T]
+		tail = seq
+	)
+	for i := 0; i < n; i++ {
+		fst, tail = tail.First()
+		val, err := fst.Return()
+		if err != nil {
+			return arr, ErrorOf[T](err)
+		}
+		arr = append(arr, val)
+	}
+	return arr, tail
+}
+
+// takeWhileFirst implements Seq.TakeWhile by repeatedly calling Seq.First.
+func takeWhileFirst[T any](seq Seq[T], pred Predicate[T]) (Slice[T], Seq[T]) {
+	var (
+		arr  []T
+		fst  opt.Opt[T]
+		tail Seq[T]
+	)
+	for fst, tail = seq.First(); fst.Ok(); fst, tail = tail.First() {
+		val := fst.Must()
+		if !pred(val) {
+			return arr, PrependOf(val, tail)
+		}
+		arr = append(arr, val)
+	}
+	return arr, ErrorOf[T](fst.Error())
+}
+
+// skipFirst implements Seq.Skip by repeatedly calling Seq.First.
+func skipFirst[T any](seq Seq[T], n int) Seq[T] {
+	var (
+		fst  opt.Opt[T]
+		tail = seq
+	)
+	for i := 0; i < n; i++ {
+		fst, tail = tail.First()
+		if err := fst.Error(); err != nil {
+			return ErrorOf[T](err)
+		}
+	}
+	return tail
+}
+
+// tailError returns the error held by a tail returned from Seq.Take, when the tail is exhausted.
+// If there is no error opt.ErrEmpty is returned.
+func tailError[T any](tail Seq[T]) error {
+	fst, _ := tail.First()
+	if err := fst.Error(); err != nil {
+		return err
+	}
+	return opt.ErrEmpty
+}
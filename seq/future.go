@@ -0,0 +1,56 @@
+package seq
+
+import (
+	"sync"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+// FuturesOf returns a seq with the results of the futures, in the order they are resolved.
+// The input seq is read in the background when the returned seq is executed, and each future
+// is waited for as soon as it is read, so results are available while the input is still being read.
+// If the input seq fails, the error is returned after the results of all the futures
+// read before the error.
+//
+// The returned seq is stateful, like seqs created from a channel, and like with Go
+// it should be read to the end, or the background goroutines are left blocked.
+// To get only the ok values, use ValuesOf on the result.
+//
+// Example, fetching URLs in parallel and handling the responses as they come in:
+//
+//	futs := seq.MappingOf(urls, func(url string) *opt.Future[*http.Response] {
+//		return opt.Promise(func(resolve func(opt.Opt[*http.Response])) {
+//			resolve(opt.Returning(http.Get(url)))
+//		})
+//	})
+//	seq.FuturesOf(futs).ForEach(handleResponse)
+func FuturesOf[T any](futures Seq[*opt.Future[T]]) Seq[opt.Opt[T]] {
+	return deferredOf(func() Seq[opt.Opt[T]] {
+		ch := make(chan opt.Opt[T])
+		wg := sync.WaitGroup{}
+
+		tailPromise := opt.Promise(func(resolve func(opt.Opt[opt.Opt[T]])) {
+			// Start waiting for each future as soon as it is read
+			res := futures.ForEach(func(fut *opt.Future[T]) {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					ch <- fut.Await()
+				}()
+			})
+
+			wg.Wait()
+			close(ch)
+
+			if err := res.Error(); err != nil {
+				resolve(opt.ErrorOf[opt.Opt[T]](err))
+			} else {
+				resolve(opt.Empty[opt.Opt[T]]())
+			}
+		})
+
+		// The tail is only executed after ch is drained and closed
+		tail := ValuesOf(SourceOf(tailPromise.Await).Limit(1))
+		return ConcatOf(ChanOf(ch), tail)
+	})
+}
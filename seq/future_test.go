@@ -0,0 +1,95 @@
+package seq_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kamstrup/fn/opt"
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func TestFuturesOfCompletionOrder(t *testing.T) {
+	gates := []chan struct{}{make(chan struct{}), make(chan struct{}), make(chan struct{})}
+	futs := seq.MappingOf(seq.RangeOf(0, 3), func(i int) *opt.Future[int] {
+		return opt.Promise(func(resolve func(opt.Opt[int])) {
+			<-gates[i]
+			resolve(opt.Of(i))
+		})
+	})
+
+	// Resolve the futures in reverse order, one at a time
+	close(gates[2])
+	fst, tail := seq.FuturesOf(futs).First()
+	fntesting.OptOf(t, fst.Must()).Is(2)
+
+	close(gates[1])
+	fst, tail = tail.First()
+	fntesting.OptOf(t, fst.Must()).Is(1)
+
+	close(gates[0])
+	fst, tail = tail.First()
+	fntesting.OptOf(t, fst.Must()).Is(0)
+
+	fst, _ = tail.First()
+	fntesting.OptOf(t, fst).IsEmpty()
+}
+
+func TestFuturesOfErrors(t *testing.T) {
+	theError := errors.New("the error")
+	futs := seq.ConcatOf(
+		seq.SingletOf(opt.Promise(func(resolve func(opt.Opt[int])) {
+			resolve(opt.ErrorOf[int](theError))
+		})),
+		seq.ErrorOf[*opt.Future[int]](theError),
+	)
+
+	var results []opt.Opt[int]
+	res := seq.FuturesOf(futs).ForEach(func(o opt.Opt[int]) {
+		results = append(results, o)
+	})
+	if len(results) != 1 || results[0].Error() != theError {
+		t.Fatalf("expected one error result, got: %v", results)
+	}
+	if res.Error() != theError {
+		t.Fatalf("expected the error from the input seq, got: %v", res.Error())
+	}
+}
+
+func TestFuturesOfValues(t *testing.T) {
+	futs := seq.MappingOf(seq.RangeOf(0, 10), func(i int) *opt.Future[int] {
+		return opt.Promise(func(resolve func(opt.Opt[int])) {
+			resolve(opt.Of(i))
+		})
+	})
+	sum := seq.Reduce(func(sum, i int) int { return sum + i }, 0, seq.ValuesOf(seq.FuturesOf(futs)))
+	fntesting.OptOf(t, sum).Is(45)
+}
+
+func TestFuturesOfStreaming(t *testing.T) {
+	resolvedFut := func(i int) *opt.Future[int] {
+		return opt.Promise(func(resolve func(opt.Opt[int])) {
+			resolve(opt.Of(i))
+		})
+	}
+
+	// The input blocks after the first future, until the gate is closed
+	gate := make(chan struct{})
+	futs := seq.ConcatOf(
+		seq.SingletOf(resolvedFut(1)),
+		seq.SourceOf(func() *opt.Future[int] {
+			<-gate
+			return resolvedFut(2)
+		}).Limit(1),
+	)
+
+	fst, tail := seq.FuturesOf(futs).First()
+	fntesting.OptOf(t, fst.Must()).Is(1)
+
+	close(gate)
+	fst, tail = tail.First()
+	fntesting.OptOf(t, fst.Must()).Is(2)
+
+	fst, _ = tail.First()
+	fntesting.OptOf(t, fst).IsEmpty()
+}
@@ -1,6 +1,7 @@
 package seq
 
 import (
+	"context"
 	"sync"
 
 	"github.com/kamstrup/fn/opt"
@@ -13,7 +14,12 @@ import (
 //
 // If you just want to execute a Seq for the sake of triggering side effects
 // you can use MapOf combined with the Do function.
+//
+// If the input seq has a context bound to it via ContextOf, no more elements are fed
+// to the worker goroutines once the context is done, and the context error is
+// returned in the tail of the result.
 func Go[S, T any](seq Seq[S], numJobs int, task FuncMap[S, T]) Seq[T] {
+	ctx := contextFrom(seq)
 	chS := make(chan S, numJobs)
 	chT := make(chan T, numJobs)
 	wg := sync.WaitGroup{}
@@ -34,7 +40,11 @@ func Go[S, T any](seq Seq[S], numJobs int, task FuncMap[S, T]) Seq[T] {
 	tailPromise := opt.Promise(func(resolve func(opt.Opt[T])) {
 		// Start pumping work into chS and indicate completion with close()
 		res := seq.ForEach(func(s S) {
-			chS <- s
+			select {
+			case chS <- s:
+			case <-ctx.Done():
+				// the seq will return ctx.Err() before the next element
+			}
 		})
 
 		close(chS)
@@ -57,3 +67,135 @@ func Go[S, T any](seq Seq[S], numJobs int, task FuncMap[S, T]) Seq[T] {
 	tail := ValuesOf(SourceOf(tailPromise.Await).Limit(1))
 	return ConcatOf(ChanOf(chT), tail)
 }
+
+// GoErr is like Go, but for tasks that can fail. It has semantics similar to an errgroup:
+// No new elements are dispatched to the workers after the first task returns an error,
+// and elements already dispatched are drained without being processed.
+// The first error is returned in the tail of the result seq, after all successful results.
+//
+// If a task panics, the panic is recovered and returned as an error of the type opt.ErrPanic.
+//
+// Errors from the input seq, as well as cancellation via ContextOf, are handled like in Go.
+func GoErr[S, T any](seq Seq[S], numJobs int, task func(S) (T, error)) Seq[T] {
+	ctx, cancel := context.WithCancel(contextFrom(seq))
+	recoveringTask := opt.RecoveringMapper(task)
+	chS := make(chan S, numJobs)
+	chT := make(chan T, numJobs)
+	wg := sync.WaitGroup{}
+	wg.Add(numJobs)
+
+	var (
+		errOnce sync.Once
+		taskErr error
+	)
+
+	// Start N goroutines doing work off chS, converting S -> T
+	for i := 0; i < numJobs; i++ {
+		go func() {
+			defer wg.Done()
+			for s := range chS {
+				if ctx.Err() != nil {
+					continue // drain chS
+				}
+				t, err := recoveringTask(s).Return()
+				if err != nil {
+					errOnce.Do(func() {
+						taskErr = err
+						cancel()
+					})
+				} else {
+					chT <- t
+				}
+			}
+		}()
+	}
+
+	tailPromise := opt.Promise(func(resolve func(opt.Opt[T])) {
+		defer cancel()
+
+		// Binding ctx to the seq stops execution of the input when a task fails
+		res := ContextOf(ctx, seq).ForEach(func(s S) {
+			select {
+			case chS <- s:
+			case <-ctx.Done():
+			}
+		})
+
+		close(chS)
+		wg.Wait()
+		close(chT)
+
+		// taskErr is safe to read after wg.Wait()
+		if taskErr != nil {
+			resolve(opt.ErrorOf[T](taskErr))
+		} else if err := res.Error(); err != nil {
+			resolve(opt.ErrorOf[T](err))
+		} else {
+			resolve(opt.Empty[T]())
+		}
+	})
+
+	tail := ValuesOf(SourceOf(tailPromise.Await).Limit(1))
+	return ConcatOf(ChanOf(chT), tail)
+}
+
+// GoOrdered is like Go, but the returned Seq[T] yields the results in the same order
+// as the elements of the input seq. The results are still computed in numJobs parallel
+// goroutines, and results that complete out of order are held back in a buffer until
+// all preceding results are ready. The size of the buffer is proportional to numJobs.
+//
+// Errors from the input seq, as well as cancellation via ContextOf, are handled like in Go.
+func GoOrdered[S, T any](seq Seq[S], numJobs int, task FuncMap[S, T]) Seq[T] {
+	type job struct {
+		s   S
+		res chan T
+	}
+
+	ctx := contextFrom(seq)
+	chJobs := make(chan job, numJobs)
+	chRes := make(chan chan T, numJobs) // result chans in the order of the input seq
+	chT := make(chan T, numJobs)
+
+	// Start N goroutines doing work off chJobs, converting S -> T.
+	// Result chans are buffered so the workers never block.
+	for i := 0; i < numJobs; i++ {
+		go func() {
+			for j := range chJobs {
+				j.res <- task(j.s)
+			}
+		}()
+	}
+
+	// Pass results on to chT in the order they were dispatched
+	go func() {
+		for res := range chRes {
+			chT <- <-res
+		}
+		close(chT)
+	}()
+
+	tailPromise := opt.Promise(func(resolve func(opt.Opt[T])) {
+		res := seq.ForEach(func(s S) {
+			j := job{s: s, res: make(chan T, 1)}
+			select {
+			case chRes <- j.res: // reserve the place in the output before dispatching
+				chJobs <- j
+			case <-ctx.Done():
+				// the seq will return ctx.Err() before the next element
+			}
+		})
+
+		close(chJobs)
+		close(chRes)
+
+		if err := res.Error(); err != nil {
+			resolve(opt.ErrorOf[T](err))
+		} else {
+			resolve(opt.Empty[T]())
+		}
+	})
+
+	// The tail is only executed after chT is drained and closed
+	tail := ValuesOf(SourceOf(tailPromise.Await).Limit(1))
+	return ConcatOf(ChanOf(chT), tail)
+}
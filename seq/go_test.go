@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/kamstrup/fn/opt"
 	"github.com/kamstrup/fn/seq"
@@ -78,3 +79,84 @@ func TestGoErrorOpt(t *testing.T) {
 		t.Fatalf("tail from Go.ForEach does not have correct error: %v", goTail)
 	}
 }
+
+func TestGoOrdered(t *testing.T) {
+	createSeq := func() seq.Seq[string] {
+		return seq.GoOrdered(seq.RangeOf(0, 10), 3, func(i int) string {
+			// make later elements complete first
+			time.Sleep(time.Duration(10-i) * time.Millisecond)
+			return strconv.FormatInt(int64(i), 10)
+		})
+	}
+	fntesting.SuiteOf(t, createSeq).Is("0", "1", "2", "3", "4", "5", "6", "7", "8", "9")
+}
+
+func TestGoOrderedErrorTail(t *testing.T) {
+	theError := errors.New("the error")
+	s := seq.ConcatOf(seq.SliceOfArgs(1, 2, 3), seq.ErrorOf[int](theError))
+	numStrs := seq.GoOrdered(s, 2, func(i int) string {
+		return strconv.FormatInt(int64(i), 10)
+	})
+
+	var arr []string
+	res := numStrs.ForEach(func(s string) {
+		arr = append(arr, s)
+	})
+
+	if err := res.Error(); err != theError {
+		t.Fatalf("expected %q, got: %q", theError, err)
+	}
+	if !reflect.DeepEqual(arr, []string{"1", "2", "3"}) {
+		t.Fatalf("bad result: %v", arr)
+	}
+}
+
+func TestGoErr(t *testing.T) {
+	numStrs := seq.GoErr(seq.SliceOfArgs(0, 1, 2, 3, 4, 5, 6, 7, 8, 9), 5, func(i int) (string, error) {
+		return strconv.FormatInt(int64(i), 10), nil
+	})
+
+	arr := make([]string, 10)
+	res := numStrs.ForEachIndex(func(i int, s string) {
+		arr[i] = s
+	})
+	if res.Error() != nil {
+		t.Fatalf("unexpected error: %v", res.Error())
+	}
+
+	arr = seq.SliceAs(arr).Sort(seq.OrderAsc[string])
+	if !reflect.DeepEqual(arr, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}) {
+		t.Fatalf("bad result: %v", arr)
+	}
+}
+
+func TestGoErrFailFast(t *testing.T) {
+	theError := errors.New("the error")
+	// The input is infinite, so this only terminates if dispatching stops on errors
+	ints := seq.GoErr(seq.RangeFrom(0), 3, func(i int) (int, error) {
+		if i == 5 {
+			return 0, theError
+		}
+		return i, nil
+	})
+
+	res := seq.Do(ints)
+	if res.Error() != theError {
+		t.Fatalf("expected %q, got: %q", theError, res.Error())
+	}
+}
+
+func TestGoErrPanic(t *testing.T) {
+	ints := seq.GoErr(seq.RangeOf(0, 10), 2, func(i int) (int, error) {
+		if i == 3 {
+			panic("at the disco")
+		}
+		return i, nil
+	})
+
+	res := seq.Do(ints)
+	var errPanic opt.ErrPanic
+	if !errors.As(res.Error(), &errPanic) || errPanic.V != "at the disco" {
+		t.Fatalf("expected panic error, got: %v", res.Error())
+	}
+}
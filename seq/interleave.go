@@ -0,0 +1,265 @@
+package seq
+
+import "github.com/kamstrup/fn/opt"
+
+type interleaveSeq[T any] struct {
+	seqs []Seq[T] // the seqs that are not yet exhausted
+	idx  int      // index in seqs of the seq to read the next element from
+}
+
+// Interleave returns a seq that takes one element from each of the input seqs in turn.
+// When an input seq is exhausted it is skipped, and the returned seq continues until all of them are exhausted.
+// If one of the input seqs fails, the returned seq stops with the error.
+//
+// Example:
+//
+//	seq.Interleave(seq.SliceOfArgs(1, 2, 3), seq.SliceOfArgs(10), seq.SliceOfArgs(100, 200))
+//	// is [1, 10, 100, 2, 200, 3]
+func Interleave[T any](seqs ...Seq[T]) Seq[T] {
+	return interleaveSeq[T]{seqs: seqs}
+}
+
+func (s interleaveSeq[T]) ForEach(f Func1[T]) opt.Opt[T] {
+	// Work on a single copy of the seqs, instead of copying them for every element via First
+	seqs := make([]Seq[T], len(s.seqs))
+	copy(seqs, s.seqs)
+
+	idx := s.idx
+	for len(seqs) > 0 {
+		fst, tail := seqs[idx].First()
+		if val, err := fst.Return(); err == nil {
+			f(val)
+			seqs[idx] = tail
+			idx = (idx + 1) % len(seqs)
+		} else if err != opt.ErrEmpty {
+			return fst
+		} else {
+			seqs = append(seqs[:idx], seqs[idx+1:]...)
+			if idx == len(seqs) {
+				idx = 0
+			}
+		}
+	}
+	return opt.Zero[T]()
+}
+
+func (s interleaveSeq[T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	i := 0
+	return s.ForEach(func(t T) {
+		f(i, t)
+		i++
+	})
+}
+
+func (s interleaveSeq[T]) Len() (int, bool) {
+	var (
+		total   = 0
+		unknown = false
+	)
+	for _, sq := range s.seqs {
+		sz, ok := sq.Len()
+		if sz == LenInfinite {
+			return LenInfinite, false
+		} else if !ok {
+			unknown = true
+		}
+		total += sz
+	}
+
+	if unknown {
+		return LenUnknown, false
+	}
+	return total, true
+}
+
+func (s interleaveSeq[T]) ToSlice() Slice[T] {
+	var arr []T
+	if sz, ok := s.Len(); ok {
+		arr = make([]T, 0, sz)
+	}
+	s.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (s interleaveSeq[T]) Limit(n int) Seq[T] {
+	return LimitOf[T](s, n)
+}
+
+func (s interleaveSeq[T]) Take(n int) (Slice[T], Seq[T]) {
+	return takeFirst[T](s, n)
+}
+
+func (s interleaveSeq[T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	return takeWhileFirst[T](s, pred)
+}
+
+func (s interleaveSeq[T]) Skip(n int) Seq[T] {
+	return skipFirst[T](s, n)
+}
+
+func (s interleaveSeq[T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  s,
+		pred: pred,
+	}
+}
+
+func (s interleaveSeq[T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  s,
+		pred: pred,
+	}
+}
+
+func (s interleaveSeq[T]) First() (opt.Opt[T], Seq[T]) {
+	seqs, idx := s.seqs, s.idx
+	for len(seqs) > 0 {
+		fst, tail := seqs[idx].First()
+		if fst.Ok() {
+			// Copy the seqs, so we do not change the seqs of the receiver
+			next := make([]Seq[T], len(seqs))
+			copy(next, seqs)
+			next[idx] = tail
+			return fst, interleaveSeq[T]{
+				seqs: next,
+				idx:  (idx + 1) % len(next),
+			}
+		} else if err := fst.Error(); err != opt.ErrEmpty {
+			return fst, ErrorOf[T](err)
+		}
+
+		// seqs[idx] is exhausted, remove it without changing the seqs of the receiver
+		next := make([]Seq[T], 0, len(seqs)-1)
+		next = append(next, seqs[:idx]...)
+		seqs = append(next, seqs[idx+1:]...)
+		if idx == len(seqs) {
+			idx = 0
+		}
+	}
+
+	return opt.Empty[T](), Empty[T]()
+}
+
+func (s interleaveSeq[T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: s,
+	}
+}
+
+type intersperseSeq[T any] struct {
+	seq     Seq[T]
+	sep     T
+	started bool // true if an element has been returned, so the next element must be preceded by sep
+}
+
+// Intersperse returns a seq with sep inserted between each of the elements of the input seq.
+//
+// Example:
+//
+//	seq.Intersperse(seq.SliceOfArgs("a", "b", "c"), ",")
+//	// is [a, ",", b, ",", c]
+func Intersperse[T any](seq Seq[T], sep T) Seq[T] {
+	return intersperseSeq[T]{
+		seq: seq,
+		sep: sep,
+	}
+}
+
+func (s intersperseSeq[T]) ForEach(f Func1[T]) opt.Opt[T] {
+	started := s.started
+	return s.seq.ForEach(func(t T) {
+		if started {
+			f(s.sep)
+		}
+		f(t)
+		started = true
+	})
+}
+
+func (s intersperseSeq[T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	i := 0
+	return s.ForEach(func(t T) {
+		f(i, t)
+		i++
+	})
+}
+
+func (s intersperseSeq[T]) Len() (int, bool) {
+	sz, ok := s.seq.Len()
+	if !ok || sz == 0 {
+		return sz, ok
+	} else if s.started {
+		return 2 * sz, true
+	}
+	return 2*sz - 1, true
+}
+
+func (s intersperseSeq[T]) ToSlice() Slice[T] {
+	var arr []T
+	if sz, ok := s.Len(); ok {
+		arr = make([]T, 0, sz)
+	}
+	s.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (s intersperseSeq[T]) Limit(n int) Seq[T] {
+	return LimitOf[T](s, n)
+}
+
+func (s intersperseSeq[T]) Take(n int) (Slice[T], Seq[T]) {
+	return takeFirst[T](s, n)
+}
+
+func (s intersperseSeq[T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	return takeWhileFirst[T](s, pred)
+}
+
+func (s intersperseSeq[T]) Skip(n int) Seq[T] {
+	return skipFirst[T](s, n)
+}
+
+func (s intersperseSeq[T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  s,
+		pred: pred,
+	}
+}
+
+func (s intersperseSeq[T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  s,
+		pred: pred,
+	}
+}
+
+func (s intersperseSeq[T]) First() (opt.Opt[T], Seq[T]) {
+	fst, tail := s.seq.First()
+	val, err := fst.Return()
+	if err != nil {
+		return fst, ErrorOf[T](err)
+	}
+
+	next := intersperseSeq[T]{
+		seq:     tail,
+		sep:     s.sep,
+		started: true,
+	}
+	if s.started {
+		// We already read the next element, so we put it back after the separator
+		return opt.Of(s.sep), PrependOf[T](val, next)
+	}
+	return fst, next
+}
+
+func (s intersperseSeq[T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: s,
+	}
+}
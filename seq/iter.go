@@ -0,0 +1,263 @@
+package seq
+
+import (
+	"iter"
+	"runtime"
+	"sync"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+// iterable is implemented by seqs that can produce a standard Go iterator
+// more efficiently than stepping through Seq.First.
+type iterable[T any] interface {
+	iterator() iter.Seq[T]
+}
+
+// Iter returns a standard Go range-over-func iterator that lazily executes the seq.
+// Iteration stops as soon as the loop body breaks out, and no further elements are executed.
+//
+// Since an iter.Seq has no way of reporting errors, iteration stops silently if the seq
+// produces an error. If you need to check for errors you must use Seq.ForEach or Seq.First.
+//
+// Example:
+//
+//	for n := range seq.Iter(seq.RangeOf(0, 10)) {
+//	    fmt.Println(n)
+//	}
+func Iter[T any](seq Seq[T]) iter.Seq[T] {
+	if it, ok := seq.(iterable[T]); ok {
+		return it.iterator()
+	}
+
+	return func(yield func(T) bool) {
+		for fst, tail := seq.First(); fst.Ok(); fst, tail = tail.First() {
+			if !yield(fst.Must()) {
+				return
+			}
+		}
+	}
+}
+
+// IterIndex is like Iter, but returns an iter.Seq2 yielding the index and element,
+// similar to Seq.ForEachIndex.
+func IterIndex[T any](seq Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for t := range Iter(seq) {
+			if !yield(i, t) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// puller holds the state shared by an iteratorSeq and all its tails.
+type puller[T any] struct {
+	mu   sync.Mutex
+	it   iter.Seq[T]      // nil once the iterator has been started
+	next func() (T, bool) // set while the iterator is being pulled
+	stop func()
+}
+
+type iteratorSeq[T any] struct {
+	p *puller[T]
+}
+
+// IteratorOf returns a Seq wrapping a standard Go iterator, such as the ones
+// returned from maps.Keys or slices.Values.
+// The length of the seq is always LenUnknown.
+//
+// The returned seq is stateful, like seqs created from a channel. The iterator is only executed once,
+// and the seq and all its tails share the position in it. This makes it safe to use with iterators
+// that can only be executed once, like ones reading lines from a file.
+// If you need to execute the elements more than once, wrap the seq with CacheOf.
+//
+// Executing the whole seq, via ForEach, ForEachIndex, or ToSlice, ranges directly over the iterator.
+// Stepping through the seq, via Seq.First, Seq.Take, and friends, uses iter.Pull,
+// which is released when the iterator is exhausted, or when the seq is garbage collected.
+func IteratorOf[T any](it iter.Seq[T]) Seq[T] {
+	return iteratorSeq[T]{p: &puller[T]{it: it}}
+}
+
+// Iterator2Of returns a Seq of Tuples wrapping a standard Go iterator over pairs,
+// such as the ones returned from maps.All.
+// See IteratorOf for details on how the returned seq behaves.
+func Iterator2Of[K comparable, V any](it iter.Seq2[K, V]) Seq[Tuple[K, V]] {
+	return IteratorOf(func(yield func(Tuple[K, V]) bool) {
+		for k, v := range it {
+			if !yield(Tuple[K, V]{k, v}) {
+				return
+			}
+		}
+	})
+}
+
+// pull returns the next element of the iterator, starting iter.Pull if needed.
+func (p *puller[T]) pull() (T, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.it != nil {
+		p.next, p.stop = iter.Pull(p.it)
+		p.it = nil
+		runtime.SetFinalizer(p, (*puller[T]).release)
+	}
+	if p.next == nil {
+		var zero T
+		return zero, false
+	}
+
+	t, ok := p.next()
+	if !ok {
+		p.releaseLocked()
+	}
+	return t, ok
+}
+
+// each calls f for each remaining element of the iterator.
+// If the iterator has not been started, it is ranged over directly, without iter.Pull.
+func (p *puller[T]) each(f Func1[T]) {
+	p.mu.Lock()
+	it := p.it
+	p.it = nil
+	p.mu.Unlock()
+
+	if it != nil {
+		for t := range it {
+			f(t)
+		}
+		return
+	}
+
+	for t, ok := p.pull(); ok; t, ok = p.pull() {
+		f(t)
+	}
+}
+
+func (p *puller[T]) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.releaseLocked()
+}
+
+func (p *puller[T]) releaseLocked() {
+	if p.stop != nil {
+		p.stop()
+	}
+	p.next, p.stop = nil, nil
+}
+
+func (s iteratorSeq[T]) ForEach(f Func1[T]) opt.Opt[T] {
+	s.p.each(f)
+	return opt.Zero[T]()
+}
+
+func (s iteratorSeq[T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	i := 0
+	s.p.each(func(t T) {
+		f(i, t)
+		i++
+	})
+	return opt.Zero[T]()
+}
+
+func (s iteratorSeq[T]) Len() (int, bool) {
+	return LenUnknown, false
+}
+
+func (s iteratorSeq[T]) ToSlice() Slice[T] {
+	var arr []T
+	s.p.each(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (s iteratorSeq[T]) Limit(n int) Seq[T] {
+	if n <= 0 {
+		return Empty[T]()
+	}
+
+	// Pull exactly n elements, so the rest are left for other tails of s
+	return IteratorOf(func(yield func(T) bool) {
+		for i := 0; i < n; i++ {
+			t, ok := s.p.pull()
+			if !ok || !yield(t) {
+				return
+			}
+		}
+	})
+}
+
+func (s iteratorSeq[T]) Take(n int) (Slice[T], Seq[T]) {
+	if n == 0 {
+		return []T{}, s
+	}
+
+	var head []T
+	for t, ok := s.p.pull(); ok; t, ok = s.p.pull() {
+		head = append(head, t)
+		if len(head) >= n {
+			return head, s
+		}
+	}
+
+	// If we get here the iterator is exhausted
+	return head, Empty[T]()
+}
+
+func (s iteratorSeq[T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	var head []T
+	for t, ok := s.p.pull(); ok; t, ok = s.p.pull() {
+		if !pred(t) {
+			return head, PrependOf[T](t, s)
+		}
+		head = append(head, t)
+	}
+
+	// If we get here the iterator is exhausted
+	return head, Empty[T]()
+}
+
+func (s iteratorSeq[T]) Skip(n int) Seq[T] {
+	if n < 0 {
+		panic("must skip >= 0 elements")
+	}
+
+	for i := 0; i < n; i++ {
+		if _, ok := s.p.pull(); !ok {
+			return Empty[T]()
+		}
+	}
+	return s
+}
+
+func (s iteratorSeq[T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  s,
+		pred: pred,
+	}
+}
+
+func (s iteratorSeq[T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  s,
+		pred: pred,
+	}
+}
+
+func (s iteratorSeq[T]) First() (opt.Opt[T], Seq[T]) {
+	if t, ok := s.p.pull(); ok {
+		return opt.Of(t), s
+	}
+	return opt.Empty[T](), Empty[T]()
+}
+
+func (s iteratorSeq[T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: s,
+	}
+}
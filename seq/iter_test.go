@@ -0,0 +1,196 @@
+package seq_test
+
+import (
+	"bufio"
+	"iter"
+	"maps"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func TestIteratorSuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.IteratorOf(slices.Values([]int{1, 2, 3, 4}))
+	}
+	fntesting.SuiteOf(t, createSeq).Is(1, 2, 3, 4)
+}
+
+func TestIteratorEmptySuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.IteratorOf(slices.Values([]int{}))
+	}
+	fntesting.SuiteOf(t, createSeq).IsEmpty()
+}
+
+func TestIteratorTakeTail(t *testing.T) {
+	sq := seq.IteratorOf(slices.Values([]int{1, 2, 3, 4, 5}))
+	head, tail := sq.Take(2)
+	if !reflect.DeepEqual(head, seq.SliceAsArgs(1, 2)) {
+		t.Fatalf("unexpected head: %v", head)
+	}
+
+	head, tail = tail.TakeWhile(seq.LessThan(4))
+	if !reflect.DeepEqual(head, seq.SliceAsArgs(3)) {
+		t.Fatalf("unexpected head: %v", head)
+	}
+	fntesting.TestOf(t, tail).Is(4, 5)
+}
+
+func TestIteratorLimitStopsEarly(t *testing.T) {
+	count := 0
+	it := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			count++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	fntesting.TestOf(t, seq.IteratorOf(it).Limit(3)).Is(0, 1, 2)
+	if count != 3 {
+		t.Fatalf("expected 3 elements executed, got %d", count)
+	}
+
+	count = 0
+	fntesting.TestOf(t, seq.IteratorOf(it).While(seq.LessThan(2))).Is(0, 1)
+	if count != 3 {
+		t.Fatalf("expected 3 elements executed, got %d", count)
+	}
+}
+
+func TestIterator2(t *testing.T) {
+	m := map[string]int{"one": 1, "two": 2}
+	res := seq.Reduce(seq.MakeMap[string, int], nil, seq.Iterator2Of(maps.All(m))).Or(nil)
+	if !reflect.DeepEqual(res, seq.MapAs(m)) {
+		t.Fatalf("unexpected result: %v", res)
+	}
+}
+
+func TestIter(t *testing.T) {
+	var arr []int
+	for i := range seq.Iter(seq.RangeOf(0, 10)) {
+		if i == 3 {
+			break
+		}
+		arr = append(arr, i)
+	}
+	if !reflect.DeepEqual(arr, []int{0, 1, 2}) {
+		t.Fatalf("unexpected result: %v", arr)
+	}
+
+	arr = slices.Collect(seq.Iter(seq.SliceOfArgs(1, 2, 3)))
+	if !reflect.DeepEqual(arr, []int{1, 2, 3}) {
+		t.Fatalf("unexpected result: %v", arr)
+	}
+
+	set := seq.SetAsArgs(1, 2, 3)
+	arr = slices.Sorted(seq.Iter(set.Seq()))
+	if !reflect.DeepEqual(arr, []int{1, 2, 3}) {
+		t.Fatalf("unexpected result: %v", arr)
+	}
+}
+
+func TestIterIndex(t *testing.T) {
+	var arr []int
+	for i, s := range seq.IterIndex(seq.SliceOfArgs(10, 11, 12)) {
+		if s != i+10 {
+			t.Fatalf("unexpected element at index %d: %d", i, s)
+		}
+		arr = append(arr, i)
+	}
+	if !reflect.DeepEqual(arr, []int{0, 1, 2}) {
+		t.Fatalf("unexpected indexes: %v", arr)
+	}
+}
+
+func TestMapAll(t *testing.T) {
+	m := seq.MapAs(map[string]int{"one": 1, "two": 2})
+	res := maps.Collect(m.All())
+	if !reflect.DeepEqual(res, map[string]int(m)) {
+		t.Fatalf("unexpected result: %v", res)
+	}
+}
+
+func TestIteratorTailsDoNotLeak(t *testing.T) {
+	before := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		sq := seq.IteratorOf(slices.Values([]int{1, 2, 3}))
+		sq.First()
+		sq.Take(1)
+	}
+
+	// Abandoned seqs release their iter.Pull when they are garbage collected
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		runtime.GC()
+		time.Sleep(time.Millisecond)
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Fatalf("abandoned tails leaked goroutines: %d before, %d after", before, after)
+	}
+
+	// Exhausted seqs release it right away
+	sq := seq.IteratorOf(slices.Values([]int{1, 2, 3}))
+	sq.First()
+	sq.ForEach(func(int) {})
+	if after := runtime.NumGoroutine(); after > before {
+		t.Fatalf("exhausted seq leaked goroutines: %d before, %d after", before, after)
+	}
+}
+
+// linesOf returns an iterator over the lines of s, that can only be executed once.
+func linesOf(s string) iter.Seq[string] {
+	sc := bufio.NewScanner(strings.NewReader(s))
+	return func(yield func(string) bool) {
+		for sc.Scan() {
+			if !yield(sc.Text()) {
+				return
+			}
+		}
+	}
+}
+
+func TestIteratorSingleUse(t *testing.T) {
+	const lines = "a\nb\nc\nd\ne\n"
+
+	head, tail := seq.IteratorOf(linesOf(lines)).Take(2)
+	if !reflect.DeepEqual(head, seq.SliceAsArgs("a", "b")) {
+		t.Fatalf("unexpected head: %v", head)
+	}
+	fntesting.TestOf(t, tail).Is("c", "d", "e")
+
+	fst, tail := seq.IteratorOf(linesOf(lines)).First()
+	fntesting.OptOf(t, fst).Is("a")
+	fntesting.TestOf(t, tail).Is("b", "c", "d", "e")
+
+	head, tail = seq.IteratorOf(linesOf(lines)).TakeWhile(seq.LessThan("c"))
+	if !reflect.DeepEqual(head, seq.SliceAsArgs("a", "b")) {
+		t.Fatalf("unexpected head: %v", head)
+	}
+	fntesting.TestOf(t, tail).Is("c", "d", "e")
+
+	fntesting.TestOf(t, seq.IteratorOf(linesOf(lines)).Skip(3)).Is("d", "e")
+	fntesting.TestOf(t, seq.CacheOf(seq.IteratorOf(linesOf(lines)))).Is("a", "b", "c", "d", "e")
+	fntesting.TestOf(t, seq.MappingOf(seq.IteratorOf(linesOf(lines)), strings.ToUpper)).Is("A", "B", "C", "D", "E")
+}
+
+func TestIteratorStateful(t *testing.T) {
+	sq := seq.IteratorOf(slices.Values([]int{1, 2, 3, 4}))
+	fst, tail := sq.First()
+	fntesting.OptOf(t, fst).Is(1)
+
+	// The seq and its tails share the position in the iterator
+	fst, _ = sq.First()
+	fntesting.OptOf(t, fst).Is(2)
+	fntesting.TestOf(t, sq.Limit(1)).Is(3)
+	fntesting.TestOf(t, tail).Is(4)
+	fntesting.TestOf(t, sq).IsEmpty()
+}
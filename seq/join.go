@@ -0,0 +1,194 @@
+package seq
+
+import (
+	"github.com/kamstrup/fn/constraints"
+	"github.com/kamstrup/fn/opt"
+)
+
+// Joined holds a pair of matching elements from the left and right side of a join.
+// For outer joins, one of the sides can be an empty opt if there was no match.
+type Joined[L, R any] struct {
+	Left  opt.Opt[L]
+	Right opt.Opt[R]
+}
+
+// InnerJoin lazily joins two seqs on keys returned by the leftKey and rightKey functions.
+// A Joined element is returned for each pair of left and right elements with equal keys.
+//
+// InnerJoin is a hash join. When the join is executed, one side is read eagerly into a map,
+// and the other side is streamed lazily. If the left seq has a well-defined length that is
+// smaller than the right side's, the left side is put in the map. Otherwise, the right side is.
+// The output is in the order of the streamed side.
+//
+// If either seq produces an error, the joined seq stops and returns that error.
+// If you need to join seqs that do not fit in memory, and they are sorted by key, see MergeJoin.
+//
+// Example, joining users with their orders:
+//
+//	userOrders := seq.InnerJoin(users, orders,
+//	    func(u User) int { return u.ID },
+//	    func(o Order) int { return o.UserID })
+func InnerJoin[L, R any, K comparable](left Seq[L], right Seq[R], leftKey FuncMap[L, K], rightKey FuncMap[R, K]) Seq[Joined[L, R]] {
+	if buildLeft(left, right) {
+		return hashJoin(right, left, rightKey, leftKey, false, false, joinedSwapped[L, R])
+	}
+	return hashJoin(left, right, leftKey, rightKey, false, false, joinedOf[L, R])
+}
+
+// LeftJoin is like InnerJoin, but also returns left elements without a matching right element.
+// For those, Joined.Right is an empty opt.
+//
+// If the left side is put in the map, the left elements without a match are returned after
+// all the matching pairs, in the order of the left seq.
+func LeftJoin[L, R any, K comparable](left Seq[L], right Seq[R], leftKey FuncMap[L, K], rightKey FuncMap[R, K]) Seq[Joined[L, R]] {
+	if buildLeft(left, right) {
+		return hashJoin(right, left, rightKey, leftKey, false, true, joinedSwapped[L, R])
+	}
+	return hashJoin(left, right, leftKey, rightKey, true, false, joinedOf[L, R])
+}
+
+// FullOuterJoin is like InnerJoin, but also returns elements from either side without a match.
+// For those, Joined.Left or Joined.Right is an empty opt.
+//
+// The elements without a match from the side put in the map, are returned after all other elements.
+func FullOuterJoin[L, R any, K comparable](left Seq[L], right Seq[R], leftKey FuncMap[L, K], rightKey FuncMap[R, K]) Seq[Joined[L, R]] {
+	if buildLeft(left, right) {
+		return hashJoin(right, left, rightKey, leftKey, true, true, joinedSwapped[L, R])
+	}
+	return hashJoin(left, right, leftKey, rightKey, true, true, joinedOf[L, R])
+}
+
+// MergeJoin is an inner join of two seqs that are both sorted ascending by key.
+// Unlike InnerJoin, MergeJoin is fully lazy and only holds the elements for one key in memory at a time,
+// so it can be used to join seqs that are larger than memory.
+//
+// If the input seqs are not sorted by key, some matches will be missed.
+// If either seq produces an error, the joined seq stops and returns that error.
+func MergeJoin[L, R any, K constraints.Ordered](left Seq[L], right Seq[R], leftKey FuncMap[L, K], rightKey FuncMap[R, K]) Seq[Joined[L, R]] {
+	type mergeState struct {
+		left  Seq[L]
+		right Seq[R]
+	}
+
+	groups := unfoldOf(mergeState{left, right}, func(st mergeState) (opt.Opt[Seq[Joined[L, R]]], mergeState) {
+		for {
+			lfst, ltail := st.left.First()
+			lval, err := lfst.Return()
+			if err != nil {
+				return opt.ErrorOf[Seq[Joined[L, R]]](err), st
+			}
+			rfst, rtail := st.right.First()
+			rval, err := rfst.Return()
+			if err != nil {
+				return opt.ErrorOf[Seq[Joined[L, R]]](err), st
+			}
+
+			lk, rk := leftKey(lval), rightKey(rval)
+			if lk < rk {
+				st = mergeState{ltail, PrependOf(rval, rtail)}
+				continue
+			} else if rk < lk {
+				st = mergeState{PrependOf(lval, ltail), rtail}
+				continue
+			}
+
+			// Keys are equal, read all elements with the same key from both sides
+			lgroup, ltail := ltail.TakeWhile(func(l L) bool { return leftKey(l) == lk })
+			rgroup, rtail := rtail.TakeWhile(func(r R) bool { return rightKey(r) == rk })
+
+			joined := make(Slice[Joined[L, R]], 0, (len(lgroup)+1)*(len(rgroup)+1))
+			for _, l := range append(Slice[L]{lval}, lgroup...) {
+				for _, r := range append(Slice[R]{rval}, rgroup...) {
+					joined = append(joined, Joined[L, R]{opt.Of(l), opt.Of(r)})
+				}
+			}
+			return opt.Of(joined.Seq()), mergeState{ltail, rtail}
+		}
+	})
+
+	return FlattenOf(groups)
+}
+
+// buildLeft returns true if the left side of a hash join should be put in the map.
+func buildLeft[L, R any](left Seq[L], right Seq[R]) bool {
+	lsz, lok := left.Len()
+	if !lok {
+		return false
+	}
+	rsz, rok := right.Len()
+	return !rok || lsz < rsz
+}
+
+func joinedOf[L, R any](l opt.Opt[L], r opt.Opt[R]) Joined[L, R] {
+	return Joined[L, R]{l, r}
+}
+
+func joinedSwapped[L, R any](r opt.Opt[R], l opt.Opt[L]) Joined[L, R] {
+	return Joined[L, R]{l, r}
+}
+
+// hashJoin reads the build seq into a map, and lazily streams the probe seq, looking up matches in the map.
+// If outerProbe is true probe elements without a match are included, and if outerBuild is true
+// build elements without a match are included at the end.
+func hashJoin[P, B, J any, K comparable](
+	probe Seq[P], build Seq[B],
+	probeKey FuncMap[P, K], buildKey FuncMap[B, K],
+	outerProbe, outerBuild bool,
+	join func(opt.Opt[P], opt.Opt[B]) J) Seq[J] {
+
+	// Build the map when the join is executed, not when it is created
+	return deferredOf(func() Seq[J] {
+		var (
+			entries Slice[B]
+			table   = make(map[K][]int) // indexes into entries
+		)
+		res := build.ForEach(func(b B) {
+			k := buildKey(b)
+			table[k] = append(table[k], len(entries))
+			entries = append(entries, b)
+		})
+		if err := res.Error(); err != nil {
+			return ErrorOf[J](err)
+		}
+
+		var matched []bool
+		if outerBuild {
+			matched = make([]bool, len(entries))
+		}
+
+		joined := FlattenOf(MappingOf(probe, func(p P) Seq[J] {
+			idxs, ok := table[probeKey(p)]
+			if !ok {
+				if outerProbe {
+					return SingletOf(join(opt.Of(p), opt.Empty[B]()))
+				}
+				return Empty[J]()
+			}
+
+			arr := make(Slice[J], len(idxs))
+			for i, idx := range idxs {
+				arr[i] = join(opt.Of(p), opt.Of(entries[idx]))
+				if outerBuild {
+					matched[idx] = true
+				}
+			}
+			return arr
+		}))
+
+		if !outerBuild {
+			return joined
+		}
+
+		// The unmatched build elements are only known when the probe seq is fully executed
+		unmatched := deferredOf(func() Seq[J] {
+			var arr Slice[J]
+			for i, b := range entries {
+				if !matched[i] {
+					arr = append(arr, join(opt.Empty[P](), opt.Of(b)))
+				}
+			}
+			return arr
+		})
+		return ConcatOf(joined, unmatched)
+	})
+}
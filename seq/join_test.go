@@ -0,0 +1,146 @@
+package seq_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kamstrup/fn/opt"
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+type user struct {
+	id   int
+	name string
+}
+
+type order struct {
+	userID int
+	item   string
+}
+
+var (
+	joinUsers = seq.SliceOfArgs(
+		user{1, "alan"},
+		user{2, "bob"},
+		user{3, "scotty"},
+	)
+	joinOrders = seq.SliceOfArgs(
+		order{2, "pizza"},
+		order{1, "coffee"},
+		order{2, "beer"},
+		order{4, "tea"},
+	)
+)
+
+func userID(u user) int {
+	return u.id
+}
+
+func orderUserID(o order) int {
+	return o.userID
+}
+
+func joinedOf(u opt.Opt[user], o opt.Opt[order]) seq.Joined[user, order] {
+	return seq.Joined[user, order]{Left: u, Right: o}
+}
+
+func TestInnerJoinSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Joined[user, order]] {
+		// users has the shortest length, so it is put in the map and orders are streamed
+		return seq.InnerJoin(joinUsers, joinOrders, userID, orderUserID)
+	}
+	fntesting.SuiteOf(t, createSeq).Is(
+		joinedOf(opt.Of(user{2, "bob"}), opt.Of(order{2, "pizza"})),
+		joinedOf(opt.Of(user{1, "alan"}), opt.Of(order{1, "coffee"})),
+		joinedOf(opt.Of(user{2, "bob"}), opt.Of(order{2, "beer"})),
+	)
+}
+
+func TestInnerJoinStreamLeftSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Joined[user, order]] {
+		// users has unknown length, so orders are put in the map and users are streamed
+		users := joinUsers.Where(func(u user) bool { return true })
+		return seq.InnerJoin(users, joinOrders, userID, orderUserID)
+	}
+	fntesting.SuiteOf(t, createSeq).Is(
+		joinedOf(opt.Of(user{1, "alan"}), opt.Of(order{1, "coffee"})),
+		joinedOf(opt.Of(user{2, "bob"}), opt.Of(order{2, "pizza"})),
+		joinedOf(opt.Of(user{2, "bob"}), opt.Of(order{2, "beer"})),
+	)
+}
+
+func TestLeftJoinSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Joined[user, order]] {
+		return seq.LeftJoin(joinUsers, joinOrders, userID, orderUserID)
+	}
+	fntesting.SuiteOf(t, createSeq).Is(
+		joinedOf(opt.Of(user{2, "bob"}), opt.Of(order{2, "pizza"})),
+		joinedOf(opt.Of(user{1, "alan"}), opt.Of(order{1, "coffee"})),
+		joinedOf(opt.Of(user{2, "bob"}), opt.Of(order{2, "beer"})),
+		joinedOf(opt.Of(user{3, "scotty"}), opt.Empty[order]()),
+	)
+}
+
+func TestFullOuterJoinSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Joined[user, order]] {
+		return seq.FullOuterJoin(joinUsers, joinOrders, userID, orderUserID)
+	}
+	fntesting.SuiteOf(t, createSeq).Is(
+		joinedOf(opt.Of(user{2, "bob"}), opt.Of(order{2, "pizza"})),
+		joinedOf(opt.Of(user{1, "alan"}), opt.Of(order{1, "coffee"})),
+		joinedOf(opt.Of(user{2, "bob"}), opt.Of(order{2, "beer"})),
+		joinedOf(opt.Empty[user](), opt.Of(order{4, "tea"})),
+		joinedOf(opt.Of(user{3, "scotty"}), opt.Empty[order]()),
+	)
+}
+
+func TestJoinError(t *testing.T) {
+	theError := errors.New("the error")
+	orders := seq.ConcatOf(joinOrders, seq.ErrorOf[order](theError))
+
+	// orders are streamed
+	res := seq.Do(seq.InnerJoin(joinUsers, orders, userID, orderUserID))
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+
+	// orders are put in the map
+	users := joinUsers.Where(func(u user) bool { return true })
+	res = seq.Do(seq.InnerJoin(users, orders, userID, orderUserID))
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+}
+
+func TestMergeJoinSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Joined[user, order]] {
+		orders := seq.SliceOfArgs(
+			order{0, "soda"},
+			order{1, "coffee"},
+			order{2, "pizza"},
+			order{2, "beer"},
+			order{4, "tea"},
+		)
+		return seq.MergeJoin(joinUsers, orders, userID, orderUserID)
+	}
+	fntesting.SuiteOf(t, createSeq).Is(
+		joinedOf(opt.Of(user{1, "alan"}), opt.Of(order{1, "coffee"})),
+		joinedOf(opt.Of(user{2, "bob"}), opt.Of(order{2, "pizza"})),
+		joinedOf(opt.Of(user{2, "bob"}), opt.Of(order{2, "beer"})),
+	)
+}
+
+func TestMergeJoinError(t *testing.T) {
+	theError := errors.New("the error")
+	orders := seq.ConcatOf(seq.SliceOfArgs(order{1, "coffee"}), seq.ErrorOf[order](theError))
+
+	var items []string
+	res := seq.MergeJoin(joinUsers, orders, userID, orderUserID).ForEach(func(j seq.Joined[user, order]) {
+		items = append(items, j.Right.Must().item)
+	})
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+	fntesting.TestOf(t, seq.SliceOf(items)).Is("coffee")
+}
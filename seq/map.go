@@ -1,6 +1,10 @@
 package seq
 
-import "github.com/kamstrup/fn/opt"
+import (
+	"iter"
+
+	"github.com/kamstrup/fn/opt"
+)
 
 // Map is a type wrapper for Go maps exposing them as a Seq of Tuple[K,V].
 //
@@ -242,6 +246,29 @@ func (a Map[K, V]) Get(k K) opt.Opt[V] {
 	return opt.Empty[V]()
 }
 
+// All returns an iterator over the keys and values in the map.
+// This is useful with functions from the standard library that take an iter.Seq2,
+// such as maps.Collect and maps.Insert.
+func (a Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range a {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (a Map[K, V]) iterator() iter.Seq[Tuple[K, V]] {
+	return func(yield func(Tuple[K, V]) bool) {
+		for k, v := range a {
+			if !yield(Tuple[K, V]{k, v}) {
+				return
+			}
+		}
+	}
+}
+
 // Copy returns a copy of this map
 func (a Map[K, V]) Copy() Map[K, V] {
 	dup := make(Map[K, V], len(a))
@@ -0,0 +1,306 @@
+package seq
+
+import (
+	"container/heap"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+type mergeSeq[T any] struct {
+	less FuncLess[T]
+	// seqs holds the input seqs until the merge seq is executed the first time.
+	seqs []Seq[T]
+	// entries holds the heads and tails of the input seqs, ordered as a binary heap.
+	entries []mergeEntry[T]
+}
+
+type mergeEntry[T any] struct {
+	val  T
+	tail Seq[T]
+	idx  int // index of the input seq, to keep the merge stable
+}
+
+// MergeSort lazily merges a collection of seqs that are already sorted according to less,
+// into one sorted seq. Elements that are equal according to less are yielded in the order
+// of the input seqs. The merge is done with a heap, so executing each element is O(log k)
+// for k input seqs.
+//
+// If all the input seqs have a well-defined length, the length of the merged seq is the sum of them.
+// If any of the input seqs produce an error, the merged seq stops and returns that error.
+//
+// Example, merging sorted outputs from 3 shards:
+//
+//	merged := seq.MergeSort(seq.OrderAsc[int], shard1, shard2, shard3)
+func MergeSort[T any](less FuncLess[T], seqs ...Seq[T]) Seq[T] {
+	if len(seqs) == 0 {
+		return Empty[T]()
+	}
+	return mergeSeq[T]{
+		less: less,
+		seqs: seqs,
+	}
+}
+
+func (m mergeSeq[T]) ForEach(f Func1[T]) opt.Opt[T] {
+	h, err := m.start()
+	if err != nil {
+		return opt.ErrorOf[T](err)
+	}
+
+	for {
+		val, err := h.next()
+		if err != nil {
+			return zeroIfEmpty(opt.ErrorOf[T](err))
+		}
+		f(val)
+	}
+}
+
+func (m mergeSeq[T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	i := 0
+	return m.ForEach(func(t T) {
+		f(i, t)
+		i++
+	})
+}
+
+func (m mergeSeq[T]) Len() (int, bool) {
+	var (
+		sz      = 0
+		unknown = false
+	)
+	addLen := func(s Seq[T]) bool {
+		l, ok := s.Len()
+		if l == LenInfinite {
+			return false
+		} else if !ok {
+			unknown = true
+		}
+		sz += l
+		return true
+	}
+
+	for _, s := range m.seqs {
+		if !addLen(s) {
+			return LenInfinite, false
+		}
+	}
+	for _, e := range m.entries {
+		sz++ // the head
+		if !addLen(e.tail) {
+			return LenInfinite, false
+		}
+	}
+
+	if unknown {
+		return LenUnknown, false
+	}
+	return sz, true
+}
+
+func (m mergeSeq[T]) ToSlice() Slice[T] {
+	var arr []T
+	if sz, ok := m.Len(); ok {
+		arr = make([]T, 0, sz)
+	}
+	m.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (m mergeSeq[T]) Limit(n int) Seq[T] {
+	return LimitOf[T](m, n)
+}
+
+func (m mergeSeq[T]) Take(n int) (Slice[T], Seq[T]) {
+	if n == 0 {
+		return []T{}, m
+	}
+
+	h, err := m.start()
+	if err != nil {
+		return nil, ErrorOf[T](err)
+	}
+
+	var arr []T
+	for i := 0; i < n; i++ {
+		val, err := h.next()
+		if err != nil {
+			return arr, ErrorOf[T](err)
+		}
+		arr = append(arr, val)
+	}
+	return arr, h.tail()
+}
+
+func (m mergeSeq[T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	h, err := m.start()
+	if err != nil {
+		return nil, ErrorOf[T](err)
+	}
+
+	var arr []T
+	for {
+		val, err := h.next()
+		if err != nil {
+			return arr, ErrorOf[T](err)
+		} else if !pred(val) {
+			return arr, PrependOf(val, h.tail())
+		}
+		arr = append(arr, val)
+	}
+}
+
+func (m mergeSeq[T]) Skip(n int) Seq[T] {
+	if n == 0 {
+		return m
+	}
+
+	h, err := m.start()
+	if err != nil {
+		return ErrorOf[T](err)
+	}
+
+	for i := 0; i < n; i++ {
+		if _, err = h.next(); err != nil {
+			return ErrorOf[T](err)
+		}
+	}
+	return h.tail()
+}
+
+func (m mergeSeq[T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  m,
+		pred: pred,
+	}
+}
+
+func (m mergeSeq[T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  m,
+		pred: pred,
+	}
+}
+
+func (m mergeSeq[T]) First() (opt.Opt[T], Seq[T]) {
+	h, err := m.start()
+	if err != nil {
+		return opt.ErrorOf[T](err), ErrorOf[T](err)
+	}
+
+	val, err := h.next()
+	if err != nil {
+		return opt.ErrorOf[T](err), ErrorOf[T](err)
+	}
+	return opt.Of(val), h.tail()
+}
+
+func (m mergeSeq[T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: m,
+	}
+}
+
+// start returns a new heap that can be mutated without changing the merge seq.
+// If the merge seq has not been executed before, the heads of all input seqs are executed.
+func (m mergeSeq[T]) start() (*mergeHeap[T], error) {
+	if m.seqs == nil {
+		entries := make([]mergeEntry[T], len(m.entries))
+		copy(entries, m.entries)
+		return &mergeHeap[T]{less: m.less, entries: entries}, nil
+	}
+
+	h := &mergeHeap[T]{
+		less:    m.less,
+		entries: make([]mergeEntry[T], 0, len(m.seqs)),
+	}
+	for i, s := range m.seqs {
+		fst, tail := s.First()
+		val, err := fst.Return()
+		if err == opt.ErrEmpty {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		h.entries = append(h.entries, mergeEntry[T]{val: val, tail: tail, idx: i})
+	}
+	heap.Init(h)
+	return h, nil
+}
+
+// mergeHeap implements heap.Interface over the heads of the seqs being merged.
+type mergeHeap[T any] struct {
+	less    FuncLess[T]
+	entries []mergeEntry[T]
+	err     error // error from an input seq, returned after the element preceding it
+}
+
+func (h *mergeHeap[T]) Len() int {
+	return len(h.entries)
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	ei, ej := h.entries[i], h.entries[j]
+	if h.less(ei.val, ej.val) {
+		return true
+	} else if h.less(ej.val, ei.val) {
+		return false
+	}
+	return ei.idx < ej.idx
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+	h.entries = append(h.entries, x.(mergeEntry[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	last := h.entries[len(h.entries)-1]
+	h.entries = h.entries[:len(h.entries)-1]
+	return last
+}
+
+// next returns the smallest element and executes the next element from the seq it came from.
+// Returns opt.ErrEmpty when all seqs are exhausted.
+func (h *mergeHeap[T]) next() (T, error) {
+	if h.err != nil {
+		var zero T
+		return zero, h.err
+	} else if len(h.entries) == 0 {
+		var zero T
+		return zero, opt.ErrEmpty
+	}
+
+	head := h.entries[0]
+	fst, tail := head.tail.First()
+	val, err := fst.Return()
+	if err == nil {
+		h.entries[0] = mergeEntry[T]{val: val, tail: tail, idx: head.idx}
+		heap.Fix(h, 0)
+	} else if err == opt.ErrEmpty {
+		heap.Pop(h)
+	} else {
+		h.err = err
+	}
+
+	return head.val, nil
+}
+
+// tail returns a merge seq with the remaining elements in the heap.
+func (h *mergeHeap[T]) tail() Seq[T] {
+	if h.err != nil {
+		return ErrorOf[T](h.err)
+	} else if len(h.entries) == 0 {
+		return Empty[T]()
+	}
+	return mergeSeq[T]{
+		less:    h.less,
+		entries: h.entries,
+	}
+}
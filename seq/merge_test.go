@@ -0,0 +1,81 @@
+package seq_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func TestMergeSortSuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.MergeSort(seq.OrderAsc[int],
+			seq.SliceOfArgs(1, 4, 7),
+			seq.Empty[int](),
+			seq.SliceOfArgs(2, 5, 8, 9),
+			seq.SliceOfArgs(3, 6))
+	}
+	fntesting.SuiteOf(t, createSeq).Is(1, 2, 3, 4, 5, 6, 7, 8, 9)
+}
+
+func TestMergeSortEmptySuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.MergeSort(seq.OrderAsc[int], seq.Empty[int](), seq.Empty[int]())
+	}
+	fntesting.SuiteOf(t, createSeq).IsEmpty()
+}
+
+func TestMergeSortLen(t *testing.T) {
+	merged := seq.MergeSort(seq.OrderAsc[int], seq.SliceOfArgs(1, 3), seq.RangeOf(0, 10))
+	fntesting.TestOf(t, merged).LenIs(12)
+
+	_, tail := merged.Take(3)
+	fntesting.TestOf(t, tail).LenIs(9)
+
+	merged = seq.MergeSort(seq.OrderAsc[int], seq.SliceOfArgs(1, 3), seq.RangeOf(0, 10).Where(seq.IsZero[int]))
+	fntesting.TestOf(t, merged).LenIs(seq.LenUnknown)
+
+	merged = seq.MergeSort(seq.OrderAsc[int], seq.SliceOfArgs(1, 3), seq.Constant(2))
+	fntesting.TestOf(t, merged).LenIs(seq.LenInfinite)
+}
+
+func TestMergeSortStable(t *testing.T) {
+	keys := seq.MergeSort(seq.OrderTupleAsc[int, string],
+		seq.SliceOfArgs(seq.TupleOf(1, "a"), seq.TupleOf(2, "a")),
+		seq.SliceOfArgs(seq.TupleOf(1, "b"), seq.TupleOf(2, "b")))
+	fntesting.TestOf(t, seq.MappingOf(keys, seq.TupleValue[int, string])).Is("a", "b", "a", "b")
+}
+
+func TestMergeSortTail(t *testing.T) {
+	merged := seq.MergeSort(seq.OrderDesc[int], seq.SliceOfArgs(9, 5, 1), seq.SliceOfArgs(8, 2))
+	fst, tail := merged.First()
+	fntesting.OptOf(t, fst).Is(9)
+
+	// the tail must be immutable, so we can execute it twice
+	fntesting.TestOf(t, tail).Is(8, 5, 2, 1)
+	fntesting.TestOf(t, tail).Is(8, 5, 2, 1)
+}
+
+func TestMergeSortError(t *testing.T) {
+	theError := errors.New("the error")
+	merged := seq.MergeSort(seq.OrderAsc[int],
+		seq.SliceOfArgs(1, 4),
+		seq.ConcatOf(seq.SliceOfArgs(2, 3), seq.ErrorOf[int](theError)))
+
+	var arr []int
+	res := merged.ForEach(func(i int) {
+		arr = append(arr, i)
+	})
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+	fntesting.TestOf(t, seq.SliceOf(arr)).Is(1, 2, 3)
+
+	head, tail := merged.Take(10)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2, 3)
+	fst, _ := tail.First()
+	if fst.Error() != theError {
+		t.Fatalf("expected the error, got: %v", fst.Error())
+	}
+}
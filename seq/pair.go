@@ -0,0 +1,52 @@
+package seq
+
+// Pair represents a pair of values.
+// Unlike Tuple, the first value is not required to be comparable,
+// so a Pair can hold fx. slices, maps, or funcs in both members.
+// They normally show up when using ZipPairOf().
+type Pair[X, Y any] struct {
+	x X
+	y Y
+}
+
+func PairOf[X, Y any](x X, y Y) Pair[X, Y] {
+	return Pair[X, Y]{x, y}
+}
+
+// PairKey returns the key of a pair.
+// A simpler variant of the method expression (seq.Pair[k,V]).Key
+func PairKey[X, Y any](p Pair[X, Y]) X {
+	return p.x
+}
+
+// PairValue returns the value of a pair.
+// A simpler variant of the method expression (seq.Pair[k,V]).Value
+func PairValue[X, Y any](p Pair[X, Y]) Y {
+	return p.y
+}
+
+// TupleFromPair converts a Pair to a Tuple, when the first member is comparable.
+// The reverse conversion is Tuple.Pair.
+func TupleFromPair[X comparable, Y any](p Pair[X, Y]) Tuple[X, Y] {
+	return Tuple[X, Y]{p.x, p.y}
+}
+
+// X is an alias for Key
+func (p Pair[X, Y]) X() X {
+	return p.x
+}
+
+// Key returns the first element in the pair
+func (p Pair[X, Y]) Key() X {
+	return p.x
+}
+
+// Y is an alias for Value
+func (p Pair[X, Y]) Y() Y {
+	return p.y
+}
+
+// Value returns the second member of the pair
+func (p Pair[X, Y]) Value() Y {
+	return p.y
+}
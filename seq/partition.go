@@ -0,0 +1,151 @@
+package seq
+
+import (
+	"sync"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+// Partition executes a seq and splits the elements into those where pred returns true, and the rest.
+// Unlike calling Where(pred) and Where(Not(pred)), the seq is only executed once.
+// Like Seq.ToSlice, errors from the seq are ignored.
+//
+// Example:
+//
+//	evens, odds := seq.Partition(nums, func(n int) bool { return n%2 == 0 })
+func Partition[T any](seq Seq[T], pred Predicate[T]) (Slice[T], Slice[T]) {
+	var yes, no Slice[T]
+	seq.ForEach(func(t T) {
+		if pred(t) {
+			yes = append(yes, t)
+		} else {
+			no = append(no, t)
+		}
+	})
+	return yes, no
+}
+
+// partitioner holds the state shared between the seqs returned by PartitionBufferBy.
+type partitioner[K comparable, T any] struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	src      Seq[T]
+	key      FuncMap[T, K]
+	keys     []K       // all keys seen so far, in order of appearance
+	bufs     map[K][]T // elements read from src but not yet read from the seq for their key
+	buffered int       // total number of elements in bufs
+	max      int       // src is not read when buffered >= max
+	reading  bool      // true while a seq reads from src without holding the lock
+	err      error     // set when src is exhausted or fails
+}
+
+// PartitionBy lazily splits a seq into sub-seqs, one for each distinct key returned by the key function.
+// It is the same as PartitionBufferBy with a buffer of 256 elements.
+func PartitionBy[K comparable, T any](seq Seq[T], key FuncMap[T, K]) Seq[Tuple[K, Seq[T]]] {
+	return PartitionBufferBy(seq, key, defaultBufferSize)
+}
+
+// PartitionBufferBy lazily splits a seq into sub-seqs, one for each distinct key returned by the key function.
+// The returned seq has a Tuple with the key and the sub-seq for each key, in the order the keys
+// appear in the input seq. Each sub-seq returns the elements with its key, in the same order as the input seq.
+// This is a lazy alternative to the GroupBy collector, and the input seq is only executed once.
+//
+// Elements read from the input are buffered until they are read from the sub-seq for their key.
+// If bufSize elements are buffered, reading from the input blocks until some of them are read.
+// This means that the sub-seqs must be executed concurrently, in separate goroutines,
+// unless the entire input fits in the buffer. Also, all the sub-seqs must be executed,
+// otherwise the others will block when the buffer is full.
+//
+// The returned seqs are stateful, like seqs created from a channel.
+// If the input seq fails, the returned seq and all the sub-seqs return the error.
+//
+// Example, processing the log lines for each host concurrently:
+//
+//	seq.PartitionBy(lines, lineHost).ForEach(func(tup seq.Tuple[string, seq.Seq[string]]) {
+//	    go processHost(tup.Key(), tup.Value())
+//	})
+func PartitionBufferBy[K comparable, T any](seq Seq[T], key FuncMap[T, K], bufSize int) Seq[Tuple[K, Seq[T]]] {
+	if bufSize < 1 {
+		panic("buffer size must be positive")
+	}
+
+	p := &partitioner[K, T]{
+		src:  seq,
+		key:  key,
+		bufs: make(map[K][]T),
+		max:  bufSize,
+	}
+	p.cond = sync.NewCond(&p.mu)
+	return unfoldOf(0, p.nextKey)
+}
+
+// nextKey returns the key with index i, and its sub-seq.
+func (p *partitioner[K, T]) nextKey(i int) (opt.Opt[Tuple[K, Seq[T]]], int) {
+	p.mu.Lock()
+	for {
+		if i < len(p.keys) {
+			k := p.keys[i]
+			p.mu.Unlock()
+			return opt.Of(Tuple[K, Seq[T]]{k, unfoldOf(k, p.next)}), i + 1
+		} else if p.err != nil {
+			err := p.err
+			p.mu.Unlock()
+			return opt.ErrorOf[Tuple[K, Seq[T]]](err), i
+		}
+		p.fill()
+	}
+}
+
+// next returns the next element with key k.
+func (p *partitioner[K, T]) next(k K) (opt.Opt[T], K) {
+	p.mu.Lock()
+	for {
+		if buf := p.bufs[k]; len(buf) > 0 {
+			t := buf[0]
+			var zero T
+			buf[0] = zero // do not keep references to read elements
+			p.bufs[k] = buf[1:]
+			p.buffered--
+			p.cond.Broadcast()
+			p.mu.Unlock()
+			return opt.Of(t), k
+		} else if p.err != nil {
+			err := p.err
+			p.mu.Unlock()
+			return opt.ErrorOf[T](err), k
+		}
+		p.fill()
+	}
+}
+
+// fill reads an element from src into the buffers, or waits if that is not possible right now.
+// The caller must hold the lock. The lock is released while reading from src.
+func (p *partitioner[K, T]) fill() {
+	if p.reading || p.buffered >= p.max {
+		p.cond.Wait()
+		return
+	}
+
+	p.reading = true
+	p.mu.Unlock()
+	fst, tail := p.src.First()
+	t, err := fst.Return()
+	var k K
+	if err == nil {
+		k = p.key(t)
+	}
+	p.mu.Lock()
+	p.reading = false
+	p.src = tail
+
+	if err != nil {
+		p.err = err
+	} else {
+		if _, ok := p.bufs[k]; !ok {
+			p.keys = append(p.keys, k)
+		}
+		p.bufs[k] = append(p.bufs[k], t)
+		p.buffered++
+	}
+	p.cond.Broadcast()
+}
@@ -0,0 +1,96 @@
+package seq_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	fnmath "github.com/kamstrup/fn/math"
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func parity(n int) string {
+	if isEven(n) {
+		return "even"
+	}
+	return "odd"
+}
+
+func TestPartition(t *testing.T) {
+	evens, odds := seq.Partition(seq.RangeOf(0, 7), isEven)
+	fntesting.TestOf(t, evens.Seq()).Is(0, 2, 4, 6)
+	fntesting.TestOf(t, odds.Seq()).Is(1, 3, 5)
+
+	evens, odds = seq.Partition(seq.Empty[int](), isEven)
+	fntesting.TestOf(t, evens.Seq()).Is()
+	fntesting.TestOf(t, odds.Seq()).Is()
+}
+
+func TestPartitionBy(t *testing.T) {
+	parts := seq.PartitionBy(seq.ChanOf(chanWithVals(1, 2, 3, 4, 5)), parity)
+
+	fst, parts := parts.First()
+	odd := fst.Must()
+	if odd.Key() != "odd" {
+		t.Fatalf("expected odd, got %s", odd.Key())
+	}
+
+	// The input fits in the buffer, so we can read the sub-seqs one after the other
+	fntesting.TestOf(t, odd.Value()).Is(1, 3, 5)
+
+	fst, parts = parts.First()
+	even := fst.Must()
+	if even.Key() != "even" {
+		t.Fatalf("expected even, got %s", even.Key())
+	}
+	fntesting.TestOf(t, even.Value()).Is(2, 4)
+
+	fst, _ = parts.First()
+	fntesting.OptOf(t, fst).IsEmpty()
+}
+
+func TestPartitionByConcurrent(t *testing.T) {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		sums = map[string]int{}
+	)
+	res := seq.PartitionBufferBy(seq.RangeOf(0, 1000), parity, 2).ForEach(func(tup seq.Tuple[string, seq.Seq[int]]) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sum := seq.Reduce(fnmath.Sum[int], 0, tup.Value()).Must()
+			mu.Lock()
+			sums[tup.Key()] = sum
+			mu.Unlock()
+		}()
+	})
+	wg.Wait()
+
+	if res.Error() != nil {
+		t.Fatalf("unexpected error: %v", res.Error())
+	}
+	if sums["even"] != 249500 || sums["odd"] != 250000 {
+		t.Fatalf("unexpected sums: %v", sums)
+	}
+}
+
+func TestPartitionByError(t *testing.T) {
+	theError := errors.New("the error")
+	parts := seq.PartitionBy(seq.ConcatOf(seq.RangeOf(0, 3), seq.ErrorOf[int](theError)), parity)
+
+	var subs []seq.Seq[int]
+	res := parts.ForEach(func(tup seq.Tuple[string, seq.Seq[int]]) {
+		subs = append(subs, tup.Value())
+	})
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+
+	for _, sub := range subs {
+		if subRes := seq.Do(sub); subRes.Error() != theError {
+			t.Fatalf("expected the error, got: %v", subRes.Error())
+		}
+	}
+}
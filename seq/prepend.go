@@ -78,14 +78,15 @@ func (p prepSeq[T]) Take(n int) (Slice[T], Seq[T]) {
 		}
 	}
 
-	var (
-		i    int
-		fst  opt.Opt[T]
-		tail Seq[T]
-	)
-	for fst, tail = p.First(); fst.Ok() && i < n; fst, tail = tail.First() {
+	var tail Seq[T] = p
+	for i := 0; i < n; i++ {
+		fst, next := tail.First()
+		if !fst.Ok() {
+			// next is empty, or an error seq if fst is an error
+			return arr, next
+		}
 		arr = append(arr, fst.Must())
-		i++
+		tail = next
 	}
 
 	return arr, tail
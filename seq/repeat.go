@@ -0,0 +1,151 @@
+package seq
+
+import "github.com/kamstrup/fn/opt"
+
+type repeatSeq[T any] struct {
+	orig Seq[T]
+	cur  Seq[T]
+	n    int  // number of times to repeat orig after cur is exhausted, or -1 for infinitely
+	any  bool // true if cur has produced any elements
+}
+
+// Cycle returns a seq that repeats the elements of the input seq infinitely.
+// The length of the returned seq is LenInfinite, unless the input seq is empty.
+// The input seq is executed again from the start every time it is exhausted,
+// so if it is stateful, like a channel or a reader, you should wrap it with CacheOf.
+//
+// If the input seq fails, the returned seq stops with the error.
+//
+// Example:
+//
+//	colors := seq.Cycle(seq.SliceOfArgs("red", "green", "blue"))
+//	// colors is [red, green, blue, red, green, blue, red, ...]
+func Cycle[T any](seq Seq[T]) Seq[T] {
+	return repeatSeq[T]{
+		orig: seq,
+		cur:  seq,
+		n:    -1,
+	}
+}
+
+// RepeatN returns a seq that repeats the elements of the input seq n times.
+// Like with Cycle, the input seq is executed again every time it is repeated.
+func RepeatN[T any](seq Seq[T], n int) Seq[T] {
+	if n <= 0 {
+		return Empty[T]()
+	}
+	return repeatSeq[T]{
+		orig: seq,
+		cur:  seq,
+		n:    n - 1,
+	}
+}
+
+func (r repeatSeq[T]) ForEach(f Func1[T]) opt.Opt[T] {
+	var (
+		fst  opt.Opt[T]
+		tail Seq[T]
+	)
+	for fst, tail = r.First(); fst.Ok(); fst, tail = tail.First() {
+		f(fst.Must())
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (r repeatSeq[T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	i := 0
+	return r.ForEach(func(t T) {
+		f(i, t)
+		i++
+	})
+}
+
+func (r repeatSeq[T]) Len() (int, bool) {
+	sz, ok := r.orig.Len()
+	if ok && sz == 0 {
+		return 0, true
+	} else if r.n < 0 || sz == LenInfinite {
+		return LenInfinite, false
+	} else if !ok {
+		return LenUnknown, false
+	}
+
+	curSz, curOk := r.cur.Len()
+	if !curOk {
+		return LenUnknown, false
+	}
+	return curSz + r.n*sz, true
+}
+
+func (r repeatSeq[T]) ToSlice() Slice[T] {
+	if sz, _ := r.Len(); sz == LenInfinite {
+		panic("cannot create Slice of infinite seq")
+	}
+
+	var arr []T
+	r.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (r repeatSeq[T]) Limit(n int) Seq[T] {
+	return LimitOf[T](r, n)
+}
+
+func (r repeatSeq[T]) Take(n int) (Slice[T], Seq[T]) {
+	return takeFirst[T](r, n)
+}
+
+func (r repeatSeq[T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	return takeWhileFirst[T](r, pred)
+}
+
+func (r repeatSeq[T]) Skip(n int) Seq[T] {
+	return skipFirst[T](r, n)
+}
+
+func (r repeatSeq[T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  r,
+		pred: pred,
+	}
+}
+
+func (r repeatSeq[T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  r,
+		pred: pred,
+	}
+}
+
+func (r repeatSeq[T]) First() (opt.Opt[T], Seq[T]) {
+	for {
+		fst, tail := r.cur.First()
+		if fst.Ok() {
+			return fst, repeatSeq[T]{
+				orig: r.orig,
+				cur:  tail,
+				n:    r.n,
+				any:  true,
+			}
+		} else if err := fst.Error(); err != opt.ErrEmpty {
+			return fst, ErrorOf[T](err)
+		} else if !r.any || r.n == 0 {
+			// Either the input seq is empty, so we would repeat nothing forever, or we are done
+			return fst, Empty[T]()
+		}
+
+		r.cur, r.any = r.orig, false
+		if r.n > 0 {
+			r.n--
+		}
+	}
+}
+
+func (r repeatSeq[T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: r,
+	}
+}
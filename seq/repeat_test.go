@@ -0,0 +1,106 @@
+package seq_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func TestCycle(t *testing.T) {
+	sq := seq.Cycle(seq.SliceOfArgs(1, 2, 3))
+	if sz, _ := sq.Len(); sz != seq.LenInfinite {
+		t.Fatalf("expected infinite length, got %d", sz)
+	}
+
+	head, tail := sq.Take(5)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2, 3, 1, 2)
+	head, _ = tail.Take(4)
+	fntesting.TestOf(t, head.Seq()).Is(3, 1, 2, 3)
+
+	fntesting.TestOf(t, sq.Limit(4)).Is(1, 2, 3, 1)
+}
+
+func TestCycleEmpty(t *testing.T) {
+	fntesting.TestOf(t, seq.Cycle(seq.Empty[int]())).Is()
+
+	// Empty, but unknown length
+	fntesting.TestOf(t, seq.Cycle(seq.SliceOfArgs(1, 2).Where(seq.IsZero[int]))).Is()
+}
+
+func TestCycleError(t *testing.T) {
+	theError := errors.New("the error")
+	sq := seq.Cycle(seq.ConcatOf(seq.SliceOfArgs(1), seq.ErrorOf[int](theError)))
+	head, tail := sq.Take(3)
+	fntesting.TestOf(t, head.Seq()).Is(1)
+	fst, _ := tail.First()
+	fntesting.OptOf(t, fst).IsError(theError)
+}
+
+func TestRepeatNSuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.RepeatN(seq.SliceOfArgs(1, 2), 3)
+	}
+	fntesting.SuiteOf(t, createSeq).Is(1, 2, 1, 2, 1, 2)
+	fntesting.TestOf(t, createSeq()).LenIs(6)
+
+	fntesting.TestOf(t, seq.RepeatN(seq.SliceOfArgs(1, 2), 0)).Is()
+	fntesting.TestOf(t, seq.RepeatN(seq.Empty[int](), 3)).Is()
+}
+
+func TestInterleaveSuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.Interleave(seq.SliceOfArgs(1, 2, 3), seq.Empty[int](), seq.SliceOfArgs(10), seq.SliceOfArgs(100, 200))
+	}
+	fntesting.SuiteOf(t, createSeq).Is(1, 10, 100, 2, 200, 3)
+	fntesting.TestOf(t, createSeq()).LenIs(6)
+
+	fntesting.TestOf(t, seq.Interleave[int]()).Is()
+}
+
+func TestInterleaveTailForEach(t *testing.T) {
+	sq := seq.Interleave(seq.SliceOfArgs(1, 2, 3), seq.SliceOfArgs(10), seq.SliceOfArgs(100, 200))
+	_, tail := sq.First()
+	fntesting.TestOf(t, tail).Is(10, 100, 2, 200, 3)
+
+	// Executing the tail must not change it
+	_, tail = tail.Take(2)
+	fntesting.TestOf(t, tail).Is(2, 200, 3)
+	fntesting.TestOf(t, tail).Is(2, 200, 3)
+}
+
+func TestInterleaveInfinite(t *testing.T) {
+	sq := seq.Interleave(seq.Constant(0), seq.SliceOfArgs(-1, -2))
+	if sz, _ := sq.Len(); sz != seq.LenInfinite {
+		t.Fatalf("expected infinite length, got %d", sz)
+	}
+	fntesting.TestOf(t, sq.Limit(6)).Is(0, -1, 0, -2, 0, 0)
+}
+
+func TestInterleaveError(t *testing.T) {
+	theError := errors.New("the error")
+	sq := seq.Interleave(seq.SliceOfArgs(1, 2, 3), seq.ConcatOf(seq.SliceOfArgs(10), seq.ErrorOf[int](theError)))
+	var arr []int
+	res := sq.ForEach(func(i int) {
+		arr = append(arr, i)
+	})
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+	fntesting.TestOf(t, seq.SliceOf(arr)).Is(1, 10, 2)
+}
+
+func TestIntersperseSuite(t *testing.T) {
+	createSeq := func() seq.Seq[string] {
+		return seq.Intersperse(seq.SliceOfArgs("a", "b", "c"), ",")
+	}
+	fntesting.SuiteOf(t, createSeq).Is("a", ",", "b", ",", "c")
+	fntesting.TestOf(t, createSeq()).LenIs(5)
+
+	_, tail := createSeq().First()
+	fntesting.TestOf(t, tail).LenIs(4)
+
+	fntesting.TestOf(t, seq.Intersperse(seq.SliceOfArgs("a"), ",")).Is("a")
+	fntesting.TestOf(t, seq.Intersperse(seq.Empty[string](), ",")).Is()
+}
@@ -0,0 +1,65 @@
+package seq
+
+import (
+	"math/rand"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+// SampleOf executes a seq and returns k elements picked uniformly at random, in a single pass.
+// It uses reservoir sampling, so only k elements are kept in memory, no matter the length of the seq.
+// If the seq has k elements or fewer, all of them are returned.
+// The order of the returned elements is not guaranteed to be random.
+//
+// The rng argument is the source of randomness. Pass a rand.Rand with a fixed seed for
+// deterministic results, or nil to use the default source from the math/rand package.
+//
+// If the seq fails, an error opt is returned.
+//
+// Example, sampling 100 lines from a log file:
+//
+//	sample := seq.SampleOf(seqio.LinesOf(r), 100, nil)
+func SampleOf[T any](seq Seq[T], k int, rng *rand.Rand) opt.Opt[Slice[T]] {
+	if k < 0 {
+		panic("sample size must be non-negative")
+	}
+
+	intn := rand.Intn
+	if rng != nil {
+		intn = rng.Intn
+	}
+
+	var reservoir Slice[T]
+	if sz, ok := seq.Len(); ok {
+		// Don't allocate more than needed, k may be very large
+		reservoir = make(Slice[T], 0, min(k, sz))
+	}
+	res := seq.ForEachIndex(func(i int, t T) {
+		if i < k {
+			reservoir = append(reservoir, t)
+		} else if j := intn(i + 1); j < k {
+			reservoir[j] = t
+		}
+	})
+
+	if err := res.Error(); err != nil {
+		return opt.ErrorOf[Slice[T]](err)
+	}
+	return opt.Of(reservoir)
+}
+
+// WhereRandom returns a lazy seq where each element of the input seq is included with probability p.
+// The rng argument works as described for SampleOf.
+//
+// Note that the returned seq draws new random numbers each time it is executed,
+// so unless you use CacheOf, executing it twice will give different results.
+func WhereRandom[T any](seq Seq[T], p float64, rng *rand.Rand) Seq[T] {
+	float64n := rand.Float64
+	if rng != nil {
+		float64n = rng.Float64
+	}
+
+	return seq.Where(func(_ T) bool {
+		return float64n() < p
+	})
+}
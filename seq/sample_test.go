@@ -0,0 +1,79 @@
+package seq_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func TestSample(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	sample := seq.SampleOf(seq.RangeOf(0, 1000), 10, rng).Must()
+	if len(sample) != 10 {
+		t.Fatalf("expected 10 elements, got %d", len(sample))
+	}
+	if distinct := seq.Distinct(sample.Seq()).ToSlice(); len(distinct) != 10 {
+		t.Fatalf("expected 10 distinct elements, got %v", distinct)
+	}
+
+	// The same seed gives the same sample
+	again := seq.SampleOf(seq.RangeOf(0, 1000), 10, rand.New(rand.NewSource(1))).Must()
+	fntesting.TestOf(t, again.Seq()).Is(sample...)
+}
+
+func TestSampleShort(t *testing.T) {
+	sample := seq.SampleOf(seq.RangeOf(0, 3), 10, nil).Must()
+	fntesting.TestOf(t, sample.Seq()).Is(0, 1, 2)
+
+	sample = seq.SampleOf(seq.Empty[int](), 10, nil).Must()
+	fntesting.TestOf(t, sample.Seq()).Is()
+}
+
+func TestSampleLargeK(t *testing.T) {
+	// k bigger than the seq must not allocate k elements up front
+	sample := seq.SampleOf(seq.RangeOf(0, 3), math.MaxInt, nil).Must()
+	fntesting.TestOf(t, sample.Seq()).Is(0, 1, 2)
+
+	unknownLen := seq.RangeOf(0, 3).Where(seq.GreaterThan(0))
+	sample = seq.SampleOf(unknownLen, math.MaxInt, nil).Must()
+	fntesting.TestOf(t, sample.Seq()).Is(1, 2)
+}
+
+func TestSampleUniform(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	counts := make([]int, 10)
+	for i := 0; i < 10_000; i++ {
+		for _, n := range seq.SampleOf(seq.RangeOf(0, 10), 1, rng).Must() {
+			counts[n]++
+		}
+	}
+
+	for n, count := range counts {
+		if count < 900 || count > 1100 {
+			t.Fatalf("element %d sampled %d times, expected around 1000", n, count)
+		}
+	}
+}
+
+func TestSampleError(t *testing.T) {
+	theError := errors.New("the error")
+	res := seq.SampleOf(seq.ConcatOf(seq.RangeOf(0, 10), seq.ErrorOf[int](theError)), 5, nil)
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+}
+
+func TestWhereRandom(t *testing.T) {
+	sq := seq.WhereRandom(seq.RangeOf(0, 10_000), 0.1, rand.New(rand.NewSource(1)))
+	sz := len(sq.ToSlice())
+	if sz < 900 || sz > 1100 {
+		t.Fatalf("expected around 1000 elements, got %d", sz)
+	}
+
+	fntesting.TestOf(t, seq.WhereRandom(seq.RangeOf(0, 5), 1, nil)).Is(0, 1, 2, 3, 4)
+	fntesting.TestOf(t, seq.WhereRandom(seq.RangeOf(0, 5), 0, nil)).Is()
+}
@@ -0,0 +1,147 @@
+package seq
+
+import "github.com/kamstrup/fn/opt"
+
+type scanSeq[T, E any] struct {
+	collector FuncCollect[T, E]
+	acc       T
+	seq       Seq[E]
+}
+
+// ScanOf is a lazy version of Reduce, that returns a seq with each intermediate result from the collector.
+// This can be used to compute running totals, running statistics, cumulative maxima, and similar.
+// The returned seq has the same length as the input seq.
+// The arguments follow the same conventions as Reduce, and it works with all the same collector functions.
+//
+// Be careful with collectors that update the collected value in place, like MakeMap, UpdateMap, or
+// MakeString. For these, each element in the scan seq will be the same instance.
+// And executing a tail more than once will apply the collector more than once to the same instance.
+//
+// Example, computing a running total:
+//
+//	totals := seq.ScanOf(fnmath.Sum[int], 0, seq.SliceOfArgs(1, 2, 3, 4))
+//	// totals is [1, 3, 6, 10]
+func ScanOf[T, E any](collector FuncCollect[T, E], seed T, seq Seq[E]) Seq[T] {
+	return scanSeq[T, E]{
+		collector: collector,
+		acc:       seed,
+		seq:       seq,
+	}
+}
+
+func (s scanSeq[T, E]) ForEach(f Func1[T]) opt.Opt[T] {
+	acc := s.acc
+	res := s.seq.ForEach(func(e E) {
+		acc = s.collector(acc, e)
+		f(acc)
+	})
+
+	if err := res.Error(); err != nil {
+		return opt.ErrorOf[T](err)
+	}
+	return opt.Zero[T]()
+}
+
+func (s scanSeq[T, E]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	acc := s.acc
+	res := s.seq.ForEachIndex(func(i int, e E) {
+		acc = s.collector(acc, e)
+		f(i, acc)
+	})
+
+	if err := res.Error(); err != nil {
+		return opt.ErrorOf[T](err)
+	}
+	return opt.Zero[T]()
+}
+
+func (s scanSeq[T, E]) Len() (int, bool) {
+	return s.seq.Len()
+}
+
+func (s scanSeq[T, E]) ToSlice() Slice[T] {
+	var arr []T
+	if sz, ok := s.Len(); ok {
+		arr = make([]T, 0, sz)
+	}
+	s.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (s scanSeq[T, E]) Limit(n int) Seq[T] {
+	return LimitOf[T](s, n)
+}
+
+func (s scanSeq[T, E]) Take(n int) (Slice[T], Seq[T]) {
+	head, tail := s.seq.Take(n)
+	arr := make(Slice[T], len(head))
+	acc := s.acc
+	for i, e := range head {
+		acc = s.collector(acc, e)
+		arr[i] = acc
+	}
+
+	return arr, scanSeq[T, E]{
+		collector: s.collector,
+		acc:       acc,
+		seq:       tail,
+	}
+}
+
+func (s scanSeq[T, E]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	// We can not use s.seq.TakeWhile because the collector would be applied twice
+	// to the element where pred returns false.
+	return takeWhileFirst[T](s, pred)
+}
+
+func (s scanSeq[T, E]) Skip(n int) Seq[T] {
+	head, tail := s.seq.Take(n)
+	acc := s.acc
+	for _, e := range head {
+		acc = s.collector(acc, e)
+	}
+
+	return scanSeq[T, E]{
+		collector: s.collector,
+		acc:       acc,
+		seq:       tail,
+	}
+}
+
+func (s scanSeq[T, E]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  s,
+		pred: pred,
+	}
+}
+
+func (s scanSeq[T, E]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  s,
+		pred: pred,
+	}
+}
+
+func (s scanSeq[T, E]) First() (opt.Opt[T], Seq[T]) {
+	fst, tail := s.seq.First()
+	e, err := fst.Return()
+	if err != nil {
+		return opt.ErrorOf[T](err), ErrorOf[T](err)
+	}
+
+	acc := s.collector(s.acc, e)
+	return opt.Of(acc), scanSeq[T, E]{
+		collector: s.collector,
+		acc:       acc,
+		seq:       tail,
+	}
+}
+
+func (s scanSeq[T, E]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: s,
+	}
+}
@@ -0,0 +1,77 @@
+package seq_test
+
+import (
+	"errors"
+	"testing"
+
+	fnmath "github.com/kamstrup/fn/math"
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func TestScanSuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.ScanOf(fnmath.Sum[int], 0, seq.SliceOfArgs(1, 2, 3, 4))
+	}
+	fntesting.SuiteOf(t, createSeq).Is(1, 3, 6, 10)
+}
+
+func TestScanEmpty(t *testing.T) {
+	sq := seq.ScanOf(fnmath.Sum[int], 0, seq.Empty[int]())
+	fntesting.TestOf(t, sq).Is()
+}
+
+func TestScanMax(t *testing.T) {
+	sq := seq.ScanOf(fnmath.Max[int], 0, seq.SliceOfArgs(2, 1, 5, 3, 7))
+	fntesting.TestOf(t, sq).Is(2, 2, 5, 5, 7)
+}
+
+func TestScanStats(t *testing.T) {
+	sq := seq.ScanOf(fnmath.MakeStats[int], fnmath.Stats[int]{}, seq.SliceOfArgs(3, 1, 2))
+	fntesting.TestOf(t, sq).Is(
+		fnmath.Stats[int]{Sum: 3, Min: 3, Max: 3, Count: 1},
+		fnmath.Stats[int]{Sum: 4, Min: 1, Max: 3, Count: 2},
+		fnmath.Stats[int]{Sum: 6, Min: 1, Max: 3, Count: 3},
+	)
+}
+
+func TestScanTakeWhile(t *testing.T) {
+	// UpdateMap mutates the map in place, so the collector must only be applied once per element
+	tups := seq.SliceOfArgs(seq.TupleOf("a", 1), seq.TupleOf("a", 2), seq.TupleOf("a", 3))
+	sq := seq.ScanOf(seq.UpdateMap[string, int](fnmath.Sum[int]), nil, tups)
+
+	head, tail := sq.TakeWhile(func(m seq.Map[string, int]) bool { return m["a"] < 3 })
+	if len(head) != 1 {
+		t.Fatalf("expected 1 element in head, got %d", len(head))
+	}
+
+	fst, tail := tail.First()
+	if fst.Must()["a"] != 3 {
+		t.Fatalf("expected 3, got %d", fst.Must()["a"])
+	}
+
+	fst, _ = tail.First()
+	if fst.Must()["a"] != 6 {
+		t.Fatalf("expected 6, got %d", fst.Must()["a"])
+	}
+}
+
+func TestScanError(t *testing.T) {
+	theError := errors.New("the error")
+	sq := seq.ScanOf(fnmath.Sum[int], 0, errorAfter(theError, 1, 2))
+
+	var totals []int
+	res := sq.ForEach(func(n int) {
+		totals = append(totals, n)
+	})
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+	fntesting.TestOf(t, seq.SliceOf(totals)).Is(1, 3)
+
+	head, tail := sq.Take(5)
+	fntesting.TestOf(t, head.Seq()).Is(1, 3)
+	if fst, _ := tail.First(); fst.Error() != theError {
+		t.Fatalf("expected the error in tail, got: %v", fst.Error())
+	}
+}
@@ -1,6 +1,10 @@
 package seq
 
-import "github.com/kamstrup/fn/opt"
+import (
+	"iter"
+
+	"github.com/kamstrup/fn/opt"
+)
 
 // Set represents a collection of unique elements, represented as a standard map of empty structs.
 // Sets can be used directly as go maps if you instantiate them via SetAs() or as a seq.Set literal.
@@ -253,6 +257,16 @@ func (s Set[K]) Intersect(other Set[K]) Seq[K] {
 	return other.Where(s.Contains)
 }
 
+func (s Set[K]) iterator() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
 // Copy returns a copy of this set
 func (s Set[K]) Copy() Set[K] {
 	dup := make(Set[K], len(s))
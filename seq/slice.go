@@ -1,6 +1,7 @@
 package seq
 
 import (
+	"iter"
 	"math/rand"
 	"sort"
 
@@ -223,6 +224,16 @@ func (a Slice[T]) Shuffle() Seq[T] {
 	return a
 }
 
+func (a Slice[T]) iterator() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range a {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
 // Copy returns a copy of this slice
 func (a Slice[T]) Copy() Slice[T] {
 	if len(a) == 0 {
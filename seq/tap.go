@@ -0,0 +1,289 @@
+package seq
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+type tapSeq[T any] struct {
+	seq Seq[T]
+	f   Func1[T]
+}
+
+// TapOf returns a lazy seq that calls f on each element as it is read from the input seq,
+// and passes the element on unchanged. This is useful for logging and debugging pipelines
+// without executing them. Elements that are skipped with Seq.Skip are not passed to f.
+//
+// Example, printing the elements that pass a filter:
+//
+//	sq = seq.TapOf(sq.Where(isGood), func(t T) { fmt.Println("good:", t) })
+func TapOf[T any](seq Seq[T], f Func1[T]) Seq[T] {
+	return tapSeq[T]{
+		seq: seq,
+		f:   f,
+	}
+}
+
+func (s tapSeq[T]) ForEach(f Func1[T]) opt.Opt[T] {
+	return s.seq.ForEach(func(t T) {
+		s.f(t)
+		f(t)
+	})
+}
+
+func (s tapSeq[T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	return s.seq.ForEachIndex(func(i int, t T) {
+		s.f(t)
+		f(i, t)
+	})
+}
+
+func (s tapSeq[T]) Len() (int, bool) {
+	return s.seq.Len()
+}
+
+func (s tapSeq[T]) ToSlice() Slice[T] {
+	var arr []T
+	if sz, ok := s.Len(); ok {
+		arr = make([]T, 0, sz)
+	}
+	s.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (s tapSeq[T]) Limit(n int) Seq[T] {
+	return LimitOf[T](s, n)
+}
+
+func (s tapSeq[T]) Take(n int) (Slice[T], Seq[T]) {
+	head, tail := s.seq.Take(n)
+	for _, t := range head {
+		s.f(t)
+	}
+	return head, tapSeq[T]{seq: tail, f: s.f}
+}
+
+func (s tapSeq[T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	// Using First, we make sure that f is called exactly once on the element where pred is false
+	return takeWhileFirst[T](s, pred)
+}
+
+func (s tapSeq[T]) Skip(n int) Seq[T] {
+	return tapSeq[T]{seq: s.seq.Skip(n), f: s.f}
+}
+
+func (s tapSeq[T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  s,
+		pred: pred,
+	}
+}
+
+func (s tapSeq[T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  s,
+		pred: pred,
+	}
+}
+
+func (s tapSeq[T]) First() (opt.Opt[T], Seq[T]) {
+	fst, tail := s.seq.First()
+	if t, err := fst.Return(); err == nil {
+		s.f(t)
+	}
+	return fst, tapSeq[T]{seq: tail, f: s.f}
+}
+
+func (s tapSeq[T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: s,
+	}
+}
+
+// StageStats holds statistics about one stage in a pipeline of seqs, recorded by InstrumentOf.
+// The zero value is ready to use, and it is safe to use from several goroutines at the same time.
+type StageStats struct {
+	count   atomic.Int64
+	errors  atomic.Int64
+	latency atomic.Int64 // nanoseconds
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+// Count returns the number of elements produced by the stage.
+func (s *StageStats) Count() int64 {
+	return s.count.Load()
+}
+
+// Errors returns the number of errors produced by the stage.
+func (s *StageStats) Errors() int64 {
+	return s.errors.Load()
+}
+
+// Err returns the last error produced by the stage, or nil.
+func (s *StageStats) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastErr
+}
+
+// Latency returns the total time spent waiting for the stage to produce elements.
+// This includes the time spent in the stages before it.
+func (s *StageStats) Latency() time.Duration {
+	return time.Duration(s.latency.Load())
+}
+
+// MeanLatency returns the average time spent waiting for each element, or 0 if there are no elements.
+func (s *StageStats) MeanLatency() time.Duration {
+	count := s.Count()
+	if count == 0 {
+		return 0
+	}
+	return s.Latency() / time.Duration(count)
+}
+
+func (s *StageStats) String() string {
+	return fmt.Sprintf("count=%d errors=%d latency=%s mean=%s", s.Count(), s.Errors(), s.Latency(), s.MeanLatency())
+}
+
+func (s *StageStats) record(n int, d time.Duration) {
+	s.count.Add(int64(n))
+	s.latency.Add(int64(d))
+}
+
+func (s *StageStats) recordError(err error) {
+	if err == nil || err == opt.ErrEmpty {
+		return
+	}
+	s.errors.Add(1)
+	s.mu.Lock()
+	s.lastErr = err
+	s.mu.Unlock()
+}
+
+type instrumentSeq[T any] struct {
+	seq   Seq[T]
+	stats *StageStats
+}
+
+// InstrumentOf returns a lazy seq that records statistics about the elements read from the input seq.
+// The number of elements, the errors, and the time spent waiting for the elements are added to stats.
+// By instrumenting each stage of a pipeline with its own StageStats, you can see how many elements
+// each stage produced, and where the time is spent.
+//
+// The time spent waiting for an element includes the time spent in the stages before it,
+// but not the time spent processing the element after it is returned.
+//
+// Example:
+//
+//	var read, good seq.StageStats
+//	sq := seq.InstrumentOf(seqio.LinesOf(r), &read)
+//	sq = seq.InstrumentOf(sq.Where(isGood), &good)
+//	seq.Do(sq)
+//	fmt.Println("read:", &read, "good:", &good)
+func InstrumentOf[T any](seq Seq[T], stats *StageStats) Seq[T] {
+	return instrumentSeq[T]{
+		seq:   seq,
+		stats: stats,
+	}
+}
+
+func (s instrumentSeq[T]) ForEach(f Func1[T]) opt.Opt[T] {
+	start := time.Now()
+	res := s.seq.ForEach(func(t T) {
+		s.stats.record(1, time.Since(start))
+		f(t)
+		start = time.Now()
+	})
+	s.stats.record(0, time.Since(start))
+	s.stats.recordError(res.Error())
+	return res
+}
+
+func (s instrumentSeq[T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	start := time.Now()
+	res := s.seq.ForEachIndex(func(i int, t T) {
+		s.stats.record(1, time.Since(start))
+		f(i, t)
+		start = time.Now()
+	})
+	s.stats.record(0, time.Since(start))
+	s.stats.recordError(res.Error())
+	return res
+}
+
+func (s instrumentSeq[T]) Len() (int, bool) {
+	return s.seq.Len()
+}
+
+func (s instrumentSeq[T]) ToSlice() Slice[T] {
+	var arr []T
+	if sz, ok := s.Len(); ok {
+		arr = make([]T, 0, sz)
+	}
+	s.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (s instrumentSeq[T]) Limit(n int) Seq[T] {
+	return LimitOf[T](s, n)
+}
+
+func (s instrumentSeq[T]) Take(n int) (Slice[T], Seq[T]) {
+	start := time.Now()
+	head, tail := s.seq.Take(n)
+	s.stats.record(len(head), time.Since(start))
+	return head, instrumentSeq[T]{seq: tail, stats: s.stats}
+}
+
+func (s instrumentSeq[T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	// Using First, we make sure that the element where pred is false is counted exactly once
+	return takeWhileFirst[T](s, pred)
+}
+
+func (s instrumentSeq[T]) Skip(n int) Seq[T] {
+	return instrumentSeq[T]{seq: s.seq.Skip(n), stats: s.stats}
+}
+
+func (s instrumentSeq[T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  s,
+		pred: pred,
+	}
+}
+
+func (s instrumentSeq[T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  s,
+		pred: pred,
+	}
+}
+
+func (s instrumentSeq[T]) First() (opt.Opt[T], Seq[T]) {
+	start := time.Now()
+	fst, tail := s.seq.First()
+	if fst.Ok() {
+		s.stats.record(1, time.Since(start))
+	} else {
+		s.stats.record(0, time.Since(start))
+		s.stats.recordError(fst.Error())
+	}
+	return fst, instrumentSeq[T]{seq: tail, stats: s.stats}
+}
+
+func (s instrumentSeq[T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: s,
+	}
+}
@@ -0,0 +1,85 @@
+package seq_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func TestTapSuite(t *testing.T) {
+	createSeq := func() seq.Seq[int] {
+		return seq.TapOf(seq.RangeOf(0, 4), func(int) {})
+	}
+	fntesting.SuiteOf(t, createSeq).Is(0, 1, 2, 3)
+}
+
+func TestTap(t *testing.T) {
+	var tapped []int
+	sq := seq.TapOf(seq.RangeOf(0, 10), func(i int) {
+		tapped = append(tapped, i)
+	}).Where(isEven).Limit(2)
+
+	if len(tapped) != 0 {
+		t.Fatalf("tap must be lazy, got %v", tapped)
+	}
+
+	fntesting.TestOf(t, sq.ToSlice().Seq()).Is(0, 2)
+	fntesting.TestOf(t, seq.SliceOf(tapped)).Is(0, 1, 2)
+}
+
+func TestTapTakeWhile(t *testing.T) {
+	var tapped []int
+	sq := seq.TapOf(seq.RangeOf(0, 5), func(i int) {
+		tapped = append(tapped, i)
+	})
+
+	head, tail := sq.TakeWhile(seq.LessThan(2))
+	fntesting.TestOf(t, head.Seq()).Is(0, 1)
+	fntesting.TestOf(t, tail).Is(2, 3, 4)
+
+	// Each element is only tapped once
+	fntesting.TestOf(t, seq.SliceOf(tapped)).Is(0, 1, 2, 3, 4)
+}
+
+func TestInstrument(t *testing.T) {
+	var all, even seq.StageStats
+	sq := seq.InstrumentOf(seq.RangeOf(0, 10), &all)
+	sq = seq.InstrumentOf(sq.Where(isEven), &even)
+
+	fntesting.TestOf(t, sq.ToSlice().Seq()).Is(0, 2, 4, 6, 8)
+	if all.Count() != 10 || even.Count() != 5 {
+		t.Fatalf("unexpected counts: all=%d even=%d", all.Count(), even.Count())
+	}
+	if all.Errors() != 0 || all.Err() != nil {
+		t.Fatalf("unexpected errors: %v", all.Err())
+	}
+	if even.Latency() < 0 || even.MeanLatency() > even.Latency() {
+		t.Fatalf("unexpected latency: %s", &even)
+	}
+
+	var evens seq.StageStats
+	sq = seq.InstrumentOf(seq.SliceOfArgs(0, 2, 4, 6, 8), &evens)
+	head, tail := sq.Take(2)
+	fntesting.TestOf(t, head.Seq()).Is(0, 2)
+	fst, _ := tail.First()
+	fntesting.OptOf(t, fst).Is(4)
+	if evens.Count() != 3 {
+		t.Fatalf("expected 3, got %d", evens.Count())
+	}
+}
+
+func TestInstrumentError(t *testing.T) {
+	theError := errors.New("the error")
+	var stats seq.StageStats
+	sq := seq.InstrumentOf(seq.ConcatOf(seq.RangeOf(0, 3), seq.ErrorOf[int](theError)), &stats)
+
+	res := seq.Do(sq)
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+	if stats.Count() != 3 || stats.Errors() != 1 || stats.Err() != theError {
+		t.Fatalf("unexpected stats: %s", &stats)
+	}
+}
@@ -0,0 +1,156 @@
+package seq
+
+import (
+	"sync"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+// defaultBufferSize is the buffer size used by TeeOf and PartitionBy.
+const defaultBufferSize = 256
+
+// teeBuffer holds the elements that have been read from the source seq by at least one
+// consumer of a tee, but not yet by all of them.
+type teeBuffer[T any] struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	src     Seq[T]
+	buf     []T
+	off     int   // index in the source seq of buf[0]
+	pos     []int // index in the source seq of the next element for each consumer
+	max     int
+	reading bool  // true while a consumer reads from src without holding the lock
+	err     error // set when src is exhausted or fails
+}
+
+// TeeOf returns n seqs that all read the same elements from one execution of the input seq.
+// This makes it possible to consume a stateful seq, like a channel or a reader, more than once.
+// It is the same as TeeBufferOf with a buffer of 256 elements.
+func TeeOf[T any](seq Seq[T], n int) []Seq[T] {
+	return TeeBufferOf(seq, n, defaultBufferSize)
+}
+
+// TeeBufferOf returns n seqs that all read the same elements from one execution of the input seq.
+// Elements read from the input are buffered until all the returned seqs have read them.
+// If one seq gets bufSize elements ahead of the slowest seq, it blocks until the slowest seq catches up.
+//
+// This means that the returned seqs must be executed concurrently, in separate goroutines,
+// unless the entire input fits in the buffer. Also, all the returned seqs must be executed,
+// otherwise the others will block when the buffer is full.
+// If you only need to reduce the elements in different ways, Broadcast does it in a single pass
+// without any of these restrictions.
+//
+// The returned seqs are stateful, like seqs created from a channel.
+// If the input seq fails, all the returned seqs return the error.
+func TeeBufferOf[T any](seq Seq[T], n int, bufSize int) []Seq[T] {
+	if bufSize < 1 {
+		panic("buffer size must be positive")
+	}
+
+	tb := &teeBuffer[T]{
+		src: seq,
+		pos: make([]int, n),
+		max: bufSize,
+	}
+	tb.cond = sync.NewCond(&tb.mu)
+
+	seqs := make([]Seq[T], n)
+	for i := range seqs {
+		seqs[i] = unfoldOf(i, tb.next)
+	}
+	return seqs
+}
+
+// next returns the next element for consumer i.
+func (tb *teeBuffer[T]) next(i int) (opt.Opt[T], int) {
+	// Note: we do not use defer to unlock, since the lock is released while reading from src
+	tb.mu.Lock()
+	for {
+		if p := tb.pos[i]; p < tb.off+len(tb.buf) {
+			t := tb.buf[p-tb.off]
+			tb.pos[i]++
+			tb.trim()
+			tb.mu.Unlock()
+			return opt.Of(t), i
+		} else if tb.err != nil {
+			err := tb.err
+			tb.mu.Unlock()
+			return opt.ErrorOf[T](err), i
+		} else if tb.reading || len(tb.buf) >= tb.max {
+			tb.cond.Wait()
+			continue
+		}
+
+		// Read the next element without holding the lock,
+		// so other consumers can read from the buffer in the meantime
+		tb.reading = true
+		tb.mu.Unlock()
+		fst, tail := tb.src.First()
+		tb.mu.Lock()
+		tb.reading = false
+		tb.src = tail
+
+		if t, err := fst.Return(); err != nil {
+			tb.err = err
+		} else {
+			tb.buf = append(tb.buf, t)
+		}
+		tb.cond.Broadcast()
+	}
+}
+
+// trim drops the elements that all consumers have read. The caller must hold the lock.
+func (tb *teeBuffer[T]) trim() {
+	minPos := tb.pos[0]
+	for _, p := range tb.pos[1:] {
+		minPos = min(minPos, p)
+	}
+	if drop := minPos - tb.off; drop > 0 {
+		clear(tb.buf[:drop]) // do not keep references to dropped elements
+		tb.buf = tb.buf[drop:]
+		tb.off = minPos
+		tb.cond.Broadcast()
+	}
+}
+
+// Broadcast executes a seq once and sends each element to all the collectors.
+// It returns a slice with the results from each collector, in the same order as the collectors.
+// Each collector starts from the zero value of T, like passing nil as the initial value to Reduce.
+// Broadcast2 and Broadcast3 can be used with collectors that produce different types.
+//
+// Like Reduce, Broadcast returns an empty opt if the seq is empty, and an error opt if the seq fails.
+//
+// Example, counting and summing in one pass:
+//
+//	res := seq.Broadcast(nums, seq.Count[int], fnmath.Sum[int])
+//	// res is an Opt[Slice[int]] with the count and the sum
+func Broadcast[T, E any](seq Seq[E], collectors ...FuncCollect[T, E]) opt.Opt[Slice[T]] {
+	into := make(Slice[T], len(collectors))
+	return Reduce(func(into Slice[T], e E) Slice[T] {
+		for i, collector := range collectors {
+			into[i] = collector(into[i], e)
+		}
+		return into
+	}, into, seq)
+}
+
+// Broadcast2 executes a seq once and sends each element to both collectors.
+// The arguments follow the same conventions as Reduce.
+//
+// Example, collecting a slice and a count in one pass:
+//
+//	res := seq.Broadcast2(seq.MakeSlice[string], nil, seq.Count[string], 0, lines)
+//	// res is an Opt[Pair[Slice[string], int]]
+func Broadcast2[T1, T2, E any](c1 FuncCollect[T1, E], into1 T1, c2 FuncCollect[T2, E], into2 T2, seq Seq[E]) opt.Opt[Pair[T1, T2]] {
+	return Reduce(func(into Pair[T1, T2], e E) Pair[T1, T2] {
+		return Pair[T1, T2]{c1(into.x, e), c2(into.y, e)}
+	}, Pair[T1, T2]{into1, into2}, seq)
+}
+
+// Broadcast3 executes a seq once and sends each element to all three collectors.
+// The arguments follow the same conventions as Reduce.
+func Broadcast3[T1, T2, T3, E any](c1 FuncCollect[T1, E], into1 T1, c2 FuncCollect[T2, E], into2 T2, c3 FuncCollect[T3, E], into3 T3, seq Seq[E]) opt.Opt[Triple[T1, T2, T3]] {
+	return Reduce(func(into Triple[T1, T2, T3], e E) Triple[T1, T2, T3] {
+		return Triple[T1, T2, T3]{c1(into.x, e), c2(into.y, e), c3(into.z, e)}
+	}, Triple[T1, T2, T3]{into1, into2, into3}, seq)
+}
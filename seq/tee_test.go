@@ -0,0 +1,97 @@
+package seq_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	fnmath "github.com/kamstrup/fn/math"
+	"github.com/kamstrup/fn/opt"
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func TestTee(t *testing.T) {
+	tees := seq.TeeOf(seq.RangeOf(0, 5), 2)
+
+	// The input fits in the buffer, so the tees can be executed one after the other
+	fntesting.TestOf(t, tees[0]).Is(0, 1, 2, 3, 4)
+	fntesting.TestOf(t, tees[1]).Is(0, 1, 2, 3, 4)
+}
+
+func TestTeeConcurrent(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := 0; i < 100; i++ {
+			ch <- i
+		}
+	}()
+
+	tees := seq.TeeBufferOf(seq.ChanOf(ch), 3, 2)
+	results := make([]opt.Opt[int], len(tees))
+	var wg sync.WaitGroup
+	for i, tee := range tees {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = seq.Reduce(fnmath.Sum[int], 0, tee)
+		}()
+	}
+	wg.Wait()
+
+	for _, res := range results {
+		fntesting.OptOf(t, res).Is(4950)
+	}
+}
+
+func TestTeeError(t *testing.T) {
+	theError := errors.New("the error")
+	tees := seq.TeeOf(seq.ConcatOf(seq.SliceOfArgs(1, 2), seq.ErrorOf[int](theError)), 2)
+
+	for _, tee := range tees {
+		head, tail := tee.Take(5)
+		fntesting.TestOf(t, head.Seq()).Is(1, 2)
+		fst, _ := tail.First()
+		fntesting.OptOf(t, fst).IsError(theError)
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	res := seq.Broadcast(seq.RangeOf(1, 5), seq.Count[int], fnmath.Sum[int], fnmath.Max[int])
+	fntesting.TestOf(t, res.Must().Seq()).Is(4, 10, 4)
+
+	res = seq.Broadcast(seq.Empty[int](), seq.Count[int], fnmath.Sum[int])
+	if !res.Empty() || res.Error() != opt.ErrEmpty {
+		t.Fatalf("expected empty opt, got: %v", res)
+	}
+}
+
+func TestBroadcast2(t *testing.T) {
+	lines := seq.SliceOfArgs("one", "two")
+	res := seq.Broadcast2(seq.MakeSlice[string], nil, seq.Count[string], 0, lines)
+	fntesting.TestOf(t, res.Must().X().Seq()).Is("one", "two")
+	if res.Must().Y() != 2 {
+		t.Fatalf("expected count 2, got %d", res.Must().Y())
+	}
+
+	theError := errors.New("the error")
+	res = seq.Broadcast2(seq.MakeSlice[string], nil, seq.Count[string], 0,
+		seq.ConcatOf(lines, seq.ErrorOf[string](theError)))
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+}
+
+func TestBroadcast3(t *testing.T) {
+	res := seq.Broadcast3(
+		seq.Count[int], 0,
+		fnmath.Max[int], 0,
+		seq.MakeSet[int], nil,
+		seq.SliceOfArgs(1, 1, 2),
+	)
+	tri := res.Must()
+	if tri.X() != 3 || tri.Y() != 2 || len(tri.Z()) != 2 {
+		t.Fatalf("unexpected result: %v", tri)
+	}
+}
@@ -0,0 +1,106 @@
+package seq
+
+import "container/heap"
+
+// TopK executes a seq and returns the k greatest elements according to less, sorted with the greatest first.
+// Only k elements are kept in memory, in a binary heap, so it runs in O(n log k) time.
+// This makes it a lot cheaper than sorting the entire seq when k is small compared to the length of the seq.
+// If the seq has fewer than k elements, all of them are returned.
+// Like Seq.ToSlice, errors from the seq are ignored. Use MakeTopK with Reduce if you need to check for errors.
+//
+// Example, finding the 10 biggest numbers:
+//
+//	biggest := seq.TopK(nums, 10, seq.OrderAsc[int])
+func TopK[T any](seq Seq[T], k int, less FuncLess[T]) Slice[T] {
+	if k < 0 {
+		panic("k must be non-negative")
+	}
+
+	var (
+		collector = MakeTopK(k, less)
+		top       Slice[T]
+	)
+	if sz, ok := seq.Len(); ok {
+		// Don't allocate more than needed, k may be very large
+		top = make(Slice[T], 0, min(k, sz))
+	}
+	seq.ForEach(func(t T) {
+		top = collector(top, t)
+	})
+	return top.Sort(func(t1, t2 T) bool {
+		return less(t2, t1)
+	})
+}
+
+// BottomK executes a seq and returns the k smallest elements according to less, sorted with the smallest first.
+// It works like TopK.
+func BottomK[T any](seq Seq[T], k int, less FuncLess[T]) Slice[T] {
+	return TopK(seq, k, func(t1, t2 T) bool {
+		return less(t2, t1)
+	})
+}
+
+// MakeTopK creates a FuncCollect for use with Reduce, that keeps the k greatest elements according to less.
+// The collected slice is ordered as a binary heap, and not sorted. You can sort it with Slice.Sort.
+//
+// Example, keeping the 10 most recent events:
+//
+//	latest := seq.Reduce(seq.MakeTopK(10, eventTimeLess), nil, events)
+func MakeTopK[T any](k int, less FuncLess[T]) FuncCollect[Slice[T], T] {
+	if k < 0 {
+		panic("k must be non-negative")
+	}
+
+	return func(into Slice[T], t T) Slice[T] {
+		h := topKHeap[T]{elems: into, less: less}
+		if len(h.elems) < k {
+			heap.Push(&h, t)
+		} else if k > 0 && less(h.elems[0], t) {
+			h.elems[0] = t
+			heap.Fix(&h, 0)
+		}
+		return h.elems
+	}
+}
+
+// GroupTopK creates a FuncCollect that takes a Seq of Tuple values and keeps the k greatest values
+// for each key in a map. The slices in the map are ordered as binary heaps, like with MakeTopK.
+// This is similar to GroupBy, except only the top k values are kept per key.
+func GroupTopK[K comparable, V any](k int, less FuncLess[V]) FuncCollect[Map[K, Slice[V]], Tuple[K, V]] {
+	collector := MakeTopK(k, less)
+	return func(into Map[K, Slice[V]], tup Tuple[K, V]) Map[K, Slice[V]] {
+		if into == nil {
+			into = make(map[K]Slice[V])
+		}
+		into[tup.Key()] = collector(into[tup.Key()], tup.Value())
+		return into
+	}
+}
+
+// topKHeap implements heap.Interface as a min-heap, so the smallest element kept is at the root.
+type topKHeap[T any] struct {
+	elems Slice[T]
+	less  FuncLess[T]
+}
+
+func (h *topKHeap[T]) Len() int {
+	return len(h.elems)
+}
+
+func (h *topKHeap[T]) Less(i, j int) bool {
+	return h.less(h.elems[i], h.elems[j])
+}
+
+func (h *topKHeap[T]) Swap(i, j int) {
+	h.elems[i], h.elems[j] = h.elems[j], h.elems[i]
+}
+
+func (h *topKHeap[T]) Push(x any) {
+	h.elems = append(h.elems, x.(T))
+}
+
+func (h *topKHeap[T]) Pop() any {
+	last := h.elems[len(h.elems)-1]
+	h.elems = h.elems[:len(h.elems)-1]
+	return last
+}
@@ -0,0 +1,76 @@
+package seq_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
+)
+
+func TestTopK(t *testing.T) {
+	nums := seq.SliceOfArgs(5, 1, 9, 3, 7, 2, 8)
+	fntesting.TestOf(t, seq.TopK(nums, 3, seq.OrderAsc[int]).Seq()).Is(9, 8, 7)
+	fntesting.TestOf(t, seq.BottomK(nums, 3, seq.OrderAsc[int]).Seq()).Is(1, 2, 3)
+	fntesting.TestOf(t, seq.TopK(nums, 3, seq.OrderDesc[int]).Seq()).Is(1, 2, 3)
+
+	fntesting.TestOf(t, seq.TopK(nums, 10, seq.OrderAsc[int]).Seq()).Is(9, 8, 7, 5, 3, 2, 1)
+	fntesting.TestOf(t, seq.TopK(nums, 0, seq.OrderAsc[int]).Seq()).Is()
+	fntesting.TestOf(t, seq.TopK(seq.Empty[int](), 3, seq.OrderAsc[int]).Seq()).Is()
+}
+
+func TestTopKLongSeq(t *testing.T) {
+	// Only k elements are kept, so we can take the top k from a very long seq
+	nums := seq.RangeOf(0, 1_000_000)
+	fntesting.TestOf(t, seq.TopK(nums, 2, seq.OrderAsc[int]).Seq()).Is(999_999, 999_998)
+}
+
+func TestTopKLargeK(t *testing.T) {
+	// k bigger than the seq must not allocate k elements up front
+	fntesting.TestOf(t, seq.TopK(seq.SliceOfArgs(1, 3, 2), math.MaxInt, seq.OrderAsc[int]).Seq()).Is(3, 2, 1)
+
+	unknownLen := seq.SliceOfArgs(1, 3, 2).Where(seq.GreaterThan(1))
+	fntesting.TestOf(t, seq.TopK(unknownLen, math.MaxInt, seq.OrderAsc[int]).Seq()).Is(3, 2)
+	fntesting.TestOf(t, seq.BottomK(unknownLen, math.MaxInt, seq.OrderAsc[int]).Seq()).Is(2, 3)
+}
+
+func TestTopKNegative(t *testing.T) {
+	for name, f := range map[string]func(){
+		"TopK":      func() { seq.TopK(seq.SliceOfArgs(1), -1, seq.OrderAsc[int]) },
+		"BottomK":   func() { seq.BottomK(seq.SliceOfArgs(1), -1, seq.OrderAsc[int]) },
+		"MakeTopK":  func() { seq.MakeTopK(-1, seq.OrderAsc[int]) },
+		"GroupTopK": func() { seq.GroupTopK[string](-1, seq.OrderAsc[int]) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != "k must be non-negative" {
+					t.Errorf("%s: unexpected panic: %v", name, r)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestMakeTopK(t *testing.T) {
+	nums := seq.SliceOfArgs(5, 1, 9, 3, 7)
+	res := seq.Reduce(seq.MakeTopK(2, seq.OrderAsc[int]), nil, nums)
+	fntesting.TestOf(t, res.Must().Sort(seq.OrderDesc[int]).Seq()).Is(9, 7)
+}
+
+func TestGroupTopK(t *testing.T) {
+	scores := seq.SliceOfArgs(
+		seq.TupleOf("alan", 3),
+		seq.TupleOf("bob", 5),
+		seq.TupleOf("alan", 9),
+		seq.TupleOf("alan", 1),
+		seq.TupleOf("bob", 2),
+		seq.TupleOf("alan", 4),
+	)
+	res := seq.Reduce(seq.GroupTopK[string](2, seq.OrderAsc[int]), nil, scores).Must()
+	if len(res) != 2 {
+		t.Fatalf("expected 2 groups, got %v", res)
+	}
+	fntesting.TestOf(t, res["alan"].Sort(seq.OrderDesc[int]).Seq()).Is(9, 4)
+	fntesting.TestOf(t, res["bob"].Sort(seq.OrderDesc[int]).Seq()).Is(5, 2)
+}
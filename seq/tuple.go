@@ -1,5 +1,7 @@
 package seq
 
+import "github.com/kamstrup/fn/opt"
+
 // Tuple represents a pair of values.
 // They normally show up when using ZipOf() or iterating over a Map[X,Y].
 type Tuple[X comparable, Y any] struct {
@@ -43,6 +45,11 @@ func (t Tuple[X, Y]) Value() Y {
 	return t.y
 }
 
+// Pair converts the tuple to a Pair. The reverse conversion is TupleFromPair.
+func (t Tuple[X, Y]) Pair() Pair[X, Y] {
+	return Pair[X, Y]{t.x, t.y}
+}
+
 func (t Tuple[X, Y]) Equals(o Tuple[X, Y]) bool {
 	// Weird && construction to avoid potential interface{} alloc.
 	// Tuple.Y is not comparable so we have to bend it a bit
@@ -52,3 +59,69 @@ func (t Tuple[X, Y]) Equals(o Tuple[X, Y]) bool {
 	}
 	return false
 }
+
+// ZipOpt combines two opts into an opt with a Tuple of their values.
+// If either opt is empty or an error, the result is empty or an error, as described by opt.Zip.
+func ZipOpt[X comparable, Y any](ox opt.Opt[X], oy opt.Opt[Y]) opt.Opt[Tuple[X, Y]] {
+	return opt.Zip(ox, oy, TupleOf[X, Y])
+}
+
+// Triple represents 3 values. They normally show up when using Zip3().
+// Unlike Tuple, none of the members are required to be comparable.
+type Triple[X, Y, Z any] struct {
+	x X
+	y Y
+	z Z
+}
+
+func TripleOf[X, Y, Z any](x X, y Y, z Z) Triple[X, Y, Z] {
+	return Triple[X, Y, Z]{x, y, z}
+}
+
+// X returns the first member of the triple
+func (t Triple[X, Y, Z]) X() X {
+	return t.x
+}
+
+// Y returns the second member of the triple
+func (t Triple[X, Y, Z]) Y() Y {
+	return t.y
+}
+
+// Z returns the third member of the triple
+func (t Triple[X, Y, Z]) Z() Z {
+	return t.z
+}
+
+// Quad represents 4 values. They normally show up when using Zip4().
+// Unlike Tuple, none of the members are required to be comparable.
+type Quad[X, Y, Z, W any] struct {
+	x X
+	y Y
+	z Z
+	w W
+}
+
+func QuadOf[X, Y, Z, W any](x X, y Y, z Z, w W) Quad[X, Y, Z, W] {
+	return Quad[X, Y, Z, W]{x, y, z, w}
+}
+
+// X returns the first member of the quad
+func (q Quad[X, Y, Z, W]) X() X {
+	return q.x
+}
+
+// Y returns the second member of the quad
+func (q Quad[X, Y, Z, W]) Y() Y {
+	return q.y
+}
+
+// Z returns the third member of the quad
+func (q Quad[X, Y, Z, W]) Z() Z {
+	return q.z
+}
+
+// W returns the fourth member of the quad
+func (q Quad[X, Y, Z, W]) W() W {
+	return q.w
+}
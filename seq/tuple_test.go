@@ -3,7 +3,9 @@ package seq_test
 import (
 	"testing"
 
+	"github.com/kamstrup/fn/opt"
 	"github.com/kamstrup/fn/seq"
+	fntesting "github.com/kamstrup/fn/testing"
 )
 
 func TestTupleEquals(t *testing.T) {
@@ -19,3 +21,20 @@ func TestTupleEquals(t *testing.T) {
 		t.Fatal("tuples (2,2) and (1, 2) must not be equal")
 	}
 }
+
+func TestTuplePair(t *testing.T) {
+	p := seq.TupleOf("one", 1).Pair()
+	if p.Key() != "one" || p.Value() != 1 {
+		t.Fatalf("unexpected pair: %v", p)
+	}
+
+	tup := seq.TupleFromPair(p)
+	if !tup.Equals(seq.TupleOf("one", 1)) {
+		t.Fatalf("unexpected tuple: %v", tup)
+	}
+}
+
+func TestZipOpt(t *testing.T) {
+	fntesting.OptOf(t, seq.ZipOpt(opt.Of("a"), opt.Of(1))).Is(seq.TupleOf("a", 1))
+	fntesting.OptOf(t, seq.ZipOpt(opt.Of("a"), opt.Empty[int]())).IsEmpty()
+}
@@ -0,0 +1,108 @@
+package seq
+
+import "github.com/kamstrup/fn/opt"
+
+// unfoldSeq is a building block for seqs that are generated from a state.
+// The next function returns the first element, and the state for the tail.
+// If next returns an empty opt the seq ends, and if it returns an error opt,
+// the seq ends with that error.
+type unfoldSeq[S, T any] struct {
+	state S
+	next  func(S) (opt.Opt[T], S)
+}
+
+func unfoldOf[S, T any](state S, next func(S) (opt.Opt[T], S)) Seq[T] {
+	return unfoldSeq[S, T]{
+		state: state,
+		next:  next,
+	}
+}
+
+// deferredOf returns a seq that calls f to create the actual seq when it is executed.
+func deferredOf[T any](f func() Seq[T]) Seq[T] {
+	return FlattenOf(SourceOf(f).Limit(1))
+}
+
+func (u unfoldSeq[S, T]) ForEach(f Func1[T]) opt.Opt[T] {
+	var (
+		fst  opt.Opt[T]
+		tail Seq[T]
+	)
+	for fst, tail = u.First(); fst.Ok(); fst, tail = tail.First() {
+		f(fst.Must())
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (u unfoldSeq[S, T]) ForEachIndex(f Func2[int, T]) opt.Opt[T] {
+	var (
+		fst  opt.Opt[T]
+		tail Seq[T]
+		i    = 0
+	)
+	for fst, tail = u.First(); fst.Ok(); fst, tail = tail.First() {
+		f(i, fst.Must())
+		i++
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (u unfoldSeq[S, T]) Len() (int, bool) {
+	return LenUnknown, false
+}
+
+func (u unfoldSeq[S, T]) ToSlice() Slice[T] {
+	var arr []T
+	u.ForEach(func(t T) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (u unfoldSeq[S, T]) Limit(n int) Seq[T] {
+	return LimitOf[T](u, n)
+}
+
+func (u unfoldSeq[S, T]) Take(n int) (Slice[T], Seq[T]) {
+	return takeFirst[T](u, n)
+}
+
+func (u unfoldSeq[S, T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
+	return takeWhileFirst[T](u, pred)
+}
+
+func (u unfoldSeq[S, T]) Skip(n int) Seq[T] {
+	return skipFirst[T](u, n)
+}
+
+func (u unfoldSeq[S, T]) Where(pred Predicate[T]) Seq[T] {
+	return whereSeq[T]{
+		seq:  u,
+		pred: pred,
+	}
+}
+
+func (u unfoldSeq[S, T]) While(pred Predicate[T]) Seq[T] {
+	return whileSeq[T]{
+		seq:  u,
+		pred: pred,
+	}
+}
+
+func (u unfoldSeq[S, T]) First() (opt.Opt[T], Seq[T]) {
+	fst, state := u.next(u.state)
+	if err := fst.Error(); err != nil {
+		return fst, ErrorOf[T](err)
+	}
+	return fst, unfoldSeq[S, T]{
+		state: state,
+		next:  u.next,
+	}
+}
+
+func (u unfoldSeq[S, T]) Map(funcMap FuncMap[T, T]) Seq[T] {
+	return mappedSeq[T, T]{
+		f:   funcMap,
+		seq: u,
+	}
+}
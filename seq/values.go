@@ -1,16 +1,174 @@
 package seq
 
-import "github.com/kamstrup/fn/opt"
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kamstrup/fn/opt"
+)
 
 type valuesSeq[T any] struct {
 	seq Seq[opt.Opt[T]]
 }
 
+// ErrorPolicy determines how ValuesPolicyOf handles error opts.
+type ErrorPolicy int
+
+const (
+	// FailFast stops at the first error opt, and returns its error. This is what ValuesOf does.
+	FailFast ErrorPolicy = iota
+	// SkipErrors ignores error opts, and only returns the values of the ok opts.
+	SkipErrors
+	// CollectErrors returns the values of all the ok opts, and when the seq is exhausted,
+	// it returns an ElementErrors with the errors of all the error opts.
+	CollectErrors
+)
+
+// ElementError is an error from a single element in a seq of opts, together with the index of the element.
+type ElementError struct {
+	Index int
+	Err   error
+}
+
+func (e ElementError) Error() string {
+	return fmt.Sprintf("element %d: %v", e.Index, e.Err)
+}
+
+func (e ElementError) Unwrap() error {
+	return e.Err
+}
+
+// ElementErrors holds the errors from several elements in a seq of opts, in the order they appeared.
+// Like errors created with errors.Join, it works with errors.Is and errors.As,
+// matching if any of the element errors match.
+type ElementErrors []ElementError
+
+func (errs ElementErrors) Error() string {
+	var sb strings.Builder
+	for i, err := range errs {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString(err.Error())
+	}
+	return sb.String()
+}
+
+func (errs ElementErrors) Unwrap() []error {
+	res := make([]error, len(errs))
+	for i, err := range errs {
+		res[i] = err
+	}
+	return res
+}
+
 // ValuesOf returns a seq that lazily converts opts into their wrapped values, stopping at the first error.
+// See ValuesPolicyOf for other ways to handle errors.
 func ValuesOf[T any](opts Seq[opt.Opt[T]]) Seq[T] {
 	return valuesSeq[T]{seq: opts}
 }
 
+// ValuesPolicyOf returns a seq that lazily converts opts into their wrapped values,
+// handling error opts according to the policy. Empty opts are skipped with SkipErrors and CollectErrors,
+// and with FailFast they end the seq, like ValuesOf.
+//
+// The policy also applies when passing the returned seq to Reduce, or other functions executing it.
+// With CollectErrors the result is an error opt holding an ElementErrors, if there were any error opts.
+// If you need both the values and the errors, use Reduce with MakeResults.
+//
+// Example, validating all inputs and reporting all the errors:
+//
+//	nums := seq.MappingOf(strs, opt.Mapper(strconv.Atoi))
+//	sum := seq.Reduce(fnmath.Sum[int], 0, seq.ValuesPolicyOf(nums, seq.CollectErrors))
+//	if err := sum.Error(); err != nil {
+//		// err is an ElementErrors with the index of each bad input
+//	}
+func ValuesPolicyOf[T any](opts Seq[opt.Opt[T]], policy ErrorPolicy) Seq[T] {
+	switch policy {
+	case FailFast:
+		return ValuesOf(opts)
+	case SkipErrors:
+		return ValuesOf(opts.Where(opt.Ok[T]))
+	case CollectErrors:
+		return unfoldOf(collectState[T]{seq: opts}, collectNext[T])
+	default:
+		panic(fmt.Sprintf("unknown error policy: %d", policy))
+	}
+}
+
+// collectState is the state of a ValuesPolicyOf with CollectErrors.
+type collectState[T any] struct {
+	seq  Seq[opt.Opt[T]]
+	idx  int // index in the input seq of the first element of seq
+	errs ElementErrors
+}
+
+func collectNext[T any](state collectState[T]) (opt.Opt[T], collectState[T]) {
+	for {
+		fst, tail := state.seq.First() // note: fst is an Opt[Opt[T]]
+		optT, err := fst.Return()
+		if err != nil {
+			// The input seq is exhausted or failed
+			if err == opt.ErrEmpty && len(state.errs) > 0 {
+				err = state.errs
+			} else if err != opt.ErrEmpty && len(state.errs) > 0 {
+				err = errors.Join(state.errs, err)
+			}
+			return opt.ErrorOf[T](err), state
+		}
+
+		state.seq = tail
+		state.idx++
+		if err = optT.Error(); err == nil {
+			return optT, state
+		} else if err != opt.ErrEmpty {
+			// Clip, so tails sharing the slice never overwrite each other's errors
+			state.errs = append(slices.Clip(state.errs), ElementError{Index: state.idx - 1, Err: err})
+		}
+	}
+}
+
+// Results holds the values and errors from a seq of opts. It is created with Reduce and MakeResults.
+// The zero value is ready to use.
+type Results[T any] struct {
+	Values Slice[T]
+	Errors ElementErrors
+	n      int // number of opts seen
+}
+
+// Err returns nil if there were no error opts, and otherwise the Errors.
+func (r Results[T]) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	return r.Errors
+}
+
+// MakeResults is a FuncCollect for use with Reduce, that collects all the values and all the errors
+// from a seq of opts in one pass. Empty opts are skipped, and not considered errors.
+// The errors are recorded with the index of the opt in the seq.
+//
+// Note that Reduce returns an empty opt for an empty seq, and that if the seq itself fails,
+// Reduce returns only that error, dropping the values and errors collected before it.
+// Check the opt returned by Reduce if the seq can fail.
+//
+// Example:
+//
+//	nums := seq.MappingOf(strs, opt.Mapper(strconv.Atoi))
+//	res := seq.Reduce(seq.MakeResults[int], seq.Results[int]{}, nums).Or(seq.Results[int]{})
+//	// res.Values holds the parsed numbers, and res.Err() any parse errors
+func MakeResults[T any](into Results[T], o opt.Opt[T]) Results[T] {
+	if val, err := o.Return(); err == nil {
+		into.Values = append(into.Values, val)
+	} else if err != opt.ErrEmpty {
+		into.Errors = append(into.Errors, ElementError{Index: into.n, Err: err})
+	}
+	into.n++
+	return into
+}
+
 func (v valuesSeq[T]) ForEach(f Func1[T]) opt.Opt[T] {
 	var (
 		val     T
@@ -53,3 +53,115 @@ func TestValuesError(t *testing.T) {
 		t.Fatalf("expected error tail: %v", tail)
 	}
 }
+
+func mixedOpts(err1, err2 error) seq.Seq[opt.Opt[int]] {
+	return seq.SliceOfArgs(opt.Of(1), opt.ErrorOf[int](err1), opt.Empty[int](), opt.Of(2), opt.ErrorOf[int](err2), opt.Of(3))
+}
+
+func TestValuesPolicySuite(t *testing.T) {
+	for _, policy := range []seq.ErrorPolicy{seq.FailFast, seq.SkipErrors, seq.CollectErrors} {
+		createSeq := func() seq.Seq[int] {
+			optInts := seq.SliceOfArgs(opt.Of(1), opt.Of(2), opt.Of(3))
+			return seq.ValuesPolicyOf(optInts, policy)
+		}
+		fntesting.SuiteOf(t, createSeq).Is(1, 2, 3)
+	}
+}
+
+func TestValuesPolicyFailFast(t *testing.T) {
+	err1, err2 := errors.New("err1"), errors.New("err2")
+	sq := seq.ValuesPolicyOf(mixedOpts(err1, err2), seq.FailFast)
+	fntesting.TestOf(t, sq.ToSlice().Seq()).Is(1) // ValuesOf.Len does not account for errors
+	if res := seq.Do(sq); res.Error() != err1 {
+		t.Fatalf("expected err1, got: %v", res.Error())
+	}
+}
+
+func TestValuesPolicySkip(t *testing.T) {
+	err1, err2 := errors.New("err1"), errors.New("err2")
+	sq := seq.ValuesPolicyOf(mixedOpts(err1, err2), seq.SkipErrors)
+	fntesting.TestOf(t, sq).Is(1, 2, 3)
+	if res := seq.Do(sq); res.Error() != nil {
+		t.Fatalf("expected no error, got: %v", res.Error())
+	}
+}
+
+func TestValuesPolicyCollect(t *testing.T) {
+	err1, err2 := errors.New("err1"), errors.New("err2")
+	sq := seq.ValuesPolicyOf(mixedOpts(err1, err2), seq.CollectErrors)
+	fntesting.TestOf(t, sq).Is(1, 2, 3)
+
+	res := seq.Reduce(seq.MakeSlice[int], nil, sq)
+	var errs seq.ElementErrors
+	if !errors.As(res.Error(), &errs) {
+		t.Fatalf("expected ElementErrors, got: %v", res.Error())
+	}
+	expect := seq.ElementErrors{{Index: 1, Err: err1}, {Index: 4, Err: err2}}
+	fntesting.TestOf(t, seq.SliceOf(errs)).Is(expect...)
+	if !errors.Is(res.Error(), err1) || !errors.Is(res.Error(), err2) {
+		t.Fatalf("errors.Is must match all element errors")
+	}
+	if res.Error().Error() != "element 1: err1\nelement 4: err2" {
+		t.Fatalf("unexpected message: %q", res.Error().Error())
+	}
+
+	// Tails must keep their own errors
+	head, tail := sq.Take(2)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2)
+	fntesting.TestOf(t, tail).Is(3)
+	if res := seq.Do(tail); !errors.Is(res.Error(), err1) || !errors.Is(res.Error(), err2) {
+		t.Fatalf("expected both errors from tail, got: %v", res.Error())
+	}
+}
+
+func TestValuesPolicyCollectSeqError(t *testing.T) {
+	err1, seqErr := errors.New("err1"), errors.New("seq error")
+	opts := seq.ConcatOf(seq.SliceOfArgs(opt.Of(1), opt.ErrorOf[int](err1)), seq.ErrorOf[opt.Opt[int]](seqErr))
+	res := seq.Do(seq.ValuesPolicyOf(opts, seq.CollectErrors))
+	if !errors.Is(res.Error(), err1) || !errors.Is(res.Error(), seqErr) {
+		t.Fatalf("expected element and seq errors, got: %v", res.Error())
+	}
+}
+
+func TestMakeResults(t *testing.T) {
+	err1, err2 := errors.New("err1"), errors.New("err2")
+	res := seq.Reduce(seq.MakeResults[int], seq.Results[int]{}, mixedOpts(err1, err2)).Must()
+	fntesting.TestOf(t, res.Values.Seq()).Is(1, 2, 3)
+
+	var elemErr seq.ElementError
+	if !errors.As(res.Err(), &elemErr) || elemErr.Index != 1 || elemErr.Err != err1 {
+		t.Fatalf("expected element 1 error, got: %v", res.Err())
+	}
+	if !errors.Is(res.Err(), err2) {
+		t.Fatalf("expected err2, got: %v", res.Err())
+	}
+
+	okRes := seq.Reduce(seq.MakeResults[int], seq.Results[int]{}, seq.SliceOfArgs(opt.Of(1))).Must()
+	if okRes.Err() != nil {
+		t.Fatalf("expected no errors, got: %v", okRes.Err())
+	}
+}
+
+func TestMakeResultsEmpty(t *testing.T) {
+	res := seq.Reduce(seq.MakeResults[int], seq.Results[int]{}, seq.SliceOfArgs[opt.Opt[int]]())
+	if res.Error() != opt.ErrEmpty {
+		t.Fatalf("expected empty result, got: %v", res)
+	}
+
+	empty := res.Or(seq.Results[int]{})
+	fntesting.TestOf(t, empty.Values.Seq()).Is()
+	if empty.Err() != nil {
+		t.Fatalf("expected no errors, got: %v", empty.Err())
+	}
+}
+
+func TestMakeResultsSeqError(t *testing.T) {
+	err1, seqErr := errors.New("err1"), errors.New("seq error")
+	nums := seq.ConcatOf(mixedOpts(err1, err1), seq.ErrorOf[opt.Opt[int]](seqErr))
+
+	// The seq error is returned, and the results collected before it are dropped
+	res := seq.Reduce(seq.MakeResults[int], seq.Results[int]{}, nums)
+	if res.Error() != seqErr {
+		t.Fatalf("expected the seq error, got: %v", res)
+	}
+}
@@ -59,23 +59,25 @@ func (ws whereSeq[T]) Limit(n int) Seq[T] {
 }
 
 func (ws whereSeq[T]) Take(n int) (Slice[T], Seq[T]) {
-	if l, _ := ws.Len(); l == 0 || n == 0 {
+	if n == 0 {
+		return Slice[T]{}, ws
+	} else if l, _ := ws.Len(); l == 0 {
 		return Slice[T](nil), Empty[T]()
 	}
 
-	// FIXME: TakeWhile + append() does double alloc! Should just be While() + Do()
 	var (
-		sz  = 0
-		arr []T
+		arr  []T
+		fst  opt.Opt[T]
+		tail Seq[T] = ws
 	)
-	_, tail := ws.TakeWhile(func(t T) bool {
-		arr = append(arr, t)
-		sz++
-		if sz == n {
-			return false
+	for len(arr) < n {
+		fst, tail = tail.First()
+		val, err := fst.Return()
+		if err != nil {
+			return arr, ErrorOf[T](err)
 		}
-		return true
-	})
+		arr = append(arr, val)
+	}
 
 	return arr, tail
 }
@@ -97,23 +99,12 @@ func (ws whereSeq[T]) TakeWhile(pred Predicate[T]) (Slice[T], Seq[T]) {
 		return false
 	})
 
-	return arr, tail
+	// The tail starts with the element where pred returned false, but the rest must still be filtered
+	return arr, whereSeq[T]{tail, ws.pred}
 }
 
 func (ws whereSeq[T]) Skip(n int) Seq[T] {
-	if n == 0 {
-		return ws
-	}
-
-	var (
-		fst  opt.Opt[T]
-		tail Seq[T]
-		i    = 0
-	)
-	for fst, tail = ws.First(); !fst.Empty() && i < n; fst, tail = tail.First() {
-		i++
-	}
-	return tail
+	return skipFirst[T](ws, n)
 }
 
 func (ws whereSeq[T]) Where(pred Predicate[T]) Seq[T] {
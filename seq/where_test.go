@@ -43,6 +43,31 @@ func TestWhereTake(t *testing.T) {
 	fntesting.TestOf(t, odds.Seq()).Is(1)
 }
 
+func TestWhereTakeTail(t *testing.T) {
+	odds, tail := seq.RangeOf(0, 10).Where(isOdd).Take(2)
+	fntesting.TestOf(t, odds.Seq()).Is(1, 3)
+	fntesting.TestOf(t, tail).Is(5, 7, 9)
+}
+
+func TestWhereTakeZero(t *testing.T) {
+	// Taking no elements must return the whole seq as the tail
+	odds, tail := seq.RangeOf(0, 10).Where(isOdd).Take(0)
+	fntesting.TestOf(t, odds.Seq()).Is()
+	fntesting.TestOf(t, tail).Is(1, 3, 5, 7, 9)
+}
+
+func TestWhereTakeWhileTail(t *testing.T) {
+	// The tail must still be filtered
+	odds, tail := seq.RangeOf(0, 10).Where(isOdd).TakeWhile(seq.LessThan(4))
+	fntesting.TestOf(t, odds.Seq()).Is(1, 3)
+	fntesting.TestOf(t, tail).Is(5, 7, 9)
+}
+
+func TestWhereSkipAll(t *testing.T) {
+	fntesting.TestOf(t, seq.RangeOf(0, 10).Where(isOdd).Skip(2)).Is(5, 7, 9)
+	fntesting.TestOf(t, seq.RangeOf(0, 10).Where(isOdd).Skip(5)).Is()
+}
+
 func TestWhereTakeWhile(t *testing.T) {
 	odds, _ := seq.RangeFrom(0).Where(isOdd).TakeWhile(func(i int) bool { return i < 5 })
 	fntesting.TestOf(t, odds.Seq()).Is(1, 3)
@@ -1,8 +1,10 @@
 package seq_test
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/kamstrup/fn/opt"
 	"github.com/kamstrup/fn/seq"
 	"github.com/kamstrup/fn/testing"
 )
@@ -47,3 +49,119 @@ func TestZipSuite(t *testing.T) {
 		seq.TupleOf(4, "four"),
 	)
 }
+
+func TestZip3Suite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Triple[int, string, []byte]] {
+		x := seq.RangeFrom(1)
+		y := seq.SliceOfArgs("one", "two", "three")
+		z := seq.SliceOfArgs([]byte("a"), []byte("b"), []byte("c"), []byte("d"))
+		return seq.Zip3(x, y, z)
+	}
+
+	fntesting.SuiteOf(t, createSeq).Is(
+		seq.TripleOf(1, "one", []byte("a")),
+		seq.TripleOf(2, "two", []byte("b")),
+		seq.TripleOf(3, "three", []byte("c")),
+	)
+
+	if sz, ok := createSeq().Len(); sz != 3 || !ok {
+		t.Fatalf("expected length 3, got %d %v", sz, ok)
+	}
+}
+
+func TestZip4Suite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Quad[int, string, bool, float64]] {
+		x := seq.SliceOfArgs(1, 2)
+		y := seq.SliceOfArgs("one", "two")
+		z := seq.SliceOfArgs(true, false)
+		w := seq.SliceOfArgs(1.5, 2.5)
+		return seq.Zip4(x, y, z, w)
+	}
+
+	fntesting.SuiteOf(t, createSeq).Is(
+		seq.QuadOf(1, "one", true, 1.5),
+		seq.QuadOf(2, "two", false, 2.5),
+	)
+}
+
+func TestZip3Error(t *testing.T) {
+	theError := errors.New("the error")
+	x := seq.RangeFrom(1)
+	y := seq.ConcatOf(seq.SliceOfArgs("one"), seq.ErrorOf[string](theError))
+	z := seq.SliceOfArgs(true, false)
+
+	res := seq.Do(seq.Zip3(x, y, z))
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+}
+
+func TestZipLongestSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Joined[int, string]] {
+		x := seq.SliceOfArgs(1, 2)
+		y := seq.SliceOfArgs("one", "two", "three")
+		return seq.ZipLongest(x, y)
+	}
+
+	fntesting.SuiteOf(t, createSeq).Is(
+		seq.Joined[int, string]{Left: opt.Of(1), Right: opt.Of("one")},
+		seq.Joined[int, string]{Left: opt.Of(2), Right: opt.Of("two")},
+		seq.Joined[int, string]{Left: opt.Empty[int](), Right: opt.Of("three")},
+	)
+
+	if sz, ok := createSeq().Len(); sz != 3 || !ok {
+		t.Fatalf("expected length 3, got %d %v", sz, ok)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	tups := seq.ZipOf(seq.SliceOfArgs("one", "two", "three"), seq.RangeFrom(1))
+	names, nums := seq.UnzipOf(tups)
+
+	// Read ahead on nums, so the names are buffered
+	head, nums := nums.Take(2)
+	fntesting.TestOf(t, head.Seq()).Is(1, 2)
+	fntesting.TestOf(t, names).Is("one", "two", "three")
+	fntesting.TestOf(t, nums).Is(3)
+}
+
+func TestUnzipError(t *testing.T) {
+	theError := errors.New("the error")
+	tups := seq.ConcatOf(seq.SliceOfArgs(seq.TupleOf("one", 1)), seq.ErrorOf[seq.Tuple[string, int]](theError))
+	names, nums := seq.UnzipOf(tups)
+
+	var arr []string
+	res := names.ForEach(func(s string) {
+		arr = append(arr, s)
+	})
+	if res.Error() != theError {
+		t.Fatalf("expected the error, got: %v", res.Error())
+	}
+	fntesting.TestOf(t, seq.SliceOf(arr)).Is("one")
+
+	fst, nums := nums.First()
+	fntesting.OptOf(t, fst).Is(1)
+	fst, _ = nums.First()
+	fntesting.OptOf(t, fst).IsError(theError)
+}
+
+func TestZipPairSuite(t *testing.T) {
+	createSeq := func() seq.Seq[seq.Pair[[]byte, []byte]] {
+		x := seq.SliceOfArgs([]byte("a"), []byte("b"), []byte("c"))
+		y := seq.SliceOfArgs([]byte("one"), []byte("two"))
+		return seq.ZipPairOf(x, y)
+	}
+
+	fntesting.SuiteOf(t, createSeq).Is(
+		seq.PairOf([]byte("a"), []byte("one")),
+		seq.PairOf([]byte("b"), []byte("two")),
+	)
+}
+
+func TestUnzipPair(t *testing.T) {
+	pairs := seq.SliceOfArgs(seq.PairOf([]byte("a"), 1), seq.PairOf([]byte("b"), 2))
+	keys, nums := seq.UnzipPairOf(pairs)
+
+	fntesting.TestOf(t, nums).Is(1, 2)
+	fntesting.TestOf(t, seq.MappingOf(keys, func(b []byte) string { return string(b) })).Is("a", "b")
+}
@@ -0,0 +1,545 @@
+package seq
+
+import (
+	"sync"
+
+	"github.com/kamstrup/fn/opt"
+)
+
+type zipPairSeq[X, Y any] struct {
+	sx Seq[X]
+	sy Seq[Y]
+}
+
+// ZipPairOf is like ZipOf, but merges the two Seqs into a series of Pair.
+// Use this when the elements of sx are not comparable, fx. when zipping two seqs of []byte.
+func ZipPairOf[X, Y any](sx Seq[X], sy Seq[Y]) Seq[Pair[X, Y]] {
+	return zipPairSeq[X, Y]{
+		sx: sx,
+		sy: sy,
+	}
+}
+
+func (z zipPairSeq[X, Y]) ForEach(f Func1[Pair[X, Y]]) opt.Opt[Pair[X, Y]] {
+	var (
+		fst  opt.Opt[Pair[X, Y]]
+		tail Seq[Pair[X, Y]]
+	)
+	for fst, tail = z.First(); fst.Ok(); fst, tail = tail.First() {
+		f(fst.Must())
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (z zipPairSeq[X, Y]) ForEachIndex(f Func2[int, Pair[X, Y]]) opt.Opt[Pair[X, Y]] {
+	i := 0
+	return z.ForEach(func(p Pair[X, Y]) {
+		f(i, p)
+		i++
+	})
+}
+
+func (z zipPairSeq[X, Y]) Len() (int, bool) {
+	sz, ok := z.sx.Len()
+	return zipLen(sz, ok, z.sy)
+}
+
+func (z zipPairSeq[X, Y]) ToSlice() Slice[Pair[X, Y]] {
+	var arr []Pair[X, Y]
+	if sz, ok := z.Len(); ok {
+		arr = make([]Pair[X, Y], 0, sz)
+	}
+	z.ForEach(func(p Pair[X, Y]) {
+		arr = append(arr, p)
+	})
+	return arr
+}
+
+func (z zipPairSeq[X, Y]) Limit(n int) Seq[Pair[X, Y]] {
+	return LimitOf[Pair[X, Y]](z, n)
+}
+
+func (z zipPairSeq[X, Y]) Take(n int) (Slice[Pair[X, Y]], Seq[Pair[X, Y]]) {
+	return takeFirst[Pair[X, Y]](z, n)
+}
+
+func (z zipPairSeq[X, Y]) TakeWhile(pred Predicate[Pair[X, Y]]) (Slice[Pair[X, Y]], Seq[Pair[X, Y]]) {
+	return takeWhileFirst[Pair[X, Y]](z, pred)
+}
+
+func (z zipPairSeq[X, Y]) Skip(n int) Seq[Pair[X, Y]] {
+	return zipPairSeq[X, Y]{
+		sx: z.sx.Skip(n),
+		sy: z.sy.Skip(n),
+	}
+}
+
+func (z zipPairSeq[X, Y]) Where(pred Predicate[Pair[X, Y]]) Seq[Pair[X, Y]] {
+	return whereSeq[Pair[X, Y]]{
+		seq:  z,
+		pred: pred,
+	}
+}
+
+func (z zipPairSeq[X, Y]) While(pred Predicate[Pair[X, Y]]) Seq[Pair[X, Y]] {
+	return whileSeq[Pair[X, Y]]{
+		seq:  z,
+		pred: pred,
+	}
+}
+
+func (z zipPairSeq[X, Y]) First() (opt.Opt[Pair[X, Y]], Seq[Pair[X, Y]]) {
+	fx, tx := z.sx.First()
+	fy, ty := z.sy.First()
+	if err := zipError(fx.Error(), fy.Error()); err != nil {
+		return opt.ErrorOf[Pair[X, Y]](err), ErrorOf[Pair[X, Y]](err)
+	}
+
+	return opt.Of(Pair[X, Y]{fx.Must(), fy.Must()}), zipPairSeq[X, Y]{tx, ty}
+}
+
+func (z zipPairSeq[X, Y]) Map(funcMap FuncMap[Pair[X, Y], Pair[X, Y]]) Seq[Pair[X, Y]] {
+	return mappedSeq[Pair[X, Y], Pair[X, Y]]{
+		f:   funcMap,
+		seq: z,
+	}
+}
+
+type zip3Seq[X, Y, Z any] struct {
+	sx Seq[X]
+	sy Seq[Y]
+	sz Seq[Z]
+}
+
+// Zip3 creates a Seq that merges three Seqs into a series of Triple.
+// Like ZipOf, the zip Seq will stop at the shortest of the Seqs.
+// Unlike ZipOf, none of the element types are required to be comparable.
+func Zip3[X, Y, Z any](sx Seq[X], sy Seq[Y], sz Seq[Z]) Seq[Triple[X, Y, Z]] {
+	return zip3Seq[X, Y, Z]{
+		sx: sx,
+		sy: sy,
+		sz: sz,
+	}
+}
+
+func (z zip3Seq[X, Y, Z]) ForEach(f Func1[Triple[X, Y, Z]]) opt.Opt[Triple[X, Y, Z]] {
+	var (
+		fst  opt.Opt[Triple[X, Y, Z]]
+		tail Seq[Triple[X, Y, Z]]
+	)
+	for fst, tail = z.First(); fst.Ok(); fst, tail = tail.First() {
+		f(fst.Must())
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (z zip3Seq[X, Y, Z]) ForEachIndex(f Func2[int, Triple[X, Y, Z]]) opt.Opt[Triple[X, Y, Z]] {
+	i := 0
+	return z.ForEach(func(t Triple[X, Y, Z]) {
+		f(i, t)
+		i++
+	})
+}
+
+func (z zip3Seq[X, Y, Z]) Len() (int, bool) {
+	sz, ok := z.sx.Len()
+	sz, ok = zipLen(sz, ok, z.sy)
+	return zipLen(sz, ok, z.sz)
+}
+
+func (z zip3Seq[X, Y, Z]) ToSlice() Slice[Triple[X, Y, Z]] {
+	var arr []Triple[X, Y, Z]
+	if sz, ok := z.Len(); ok {
+		arr = make([]Triple[X, Y, Z], 0, sz)
+	}
+	z.ForEach(func(t Triple[X, Y, Z]) {
+		arr = append(arr, t)
+	})
+	return arr
+}
+
+func (z zip3Seq[X, Y, Z]) Limit(n int) Seq[Triple[X, Y, Z]] {
+	return LimitOf[Triple[X, Y, Z]](z, n)
+}
+
+func (z zip3Seq[X, Y, Z]) Take(n int) (Slice[Triple[X, Y, Z]], Seq[Triple[X, Y, Z]]) {
+	return takeFirst[Triple[X, Y, Z]](z, n)
+}
+
+func (z zip3Seq[X, Y, Z]) TakeWhile(pred Predicate[Triple[X, Y, Z]]) (Slice[Triple[X, Y, Z]], Seq[Triple[X, Y, Z]]) {
+	return takeWhileFirst[Triple[X, Y, Z]](z, pred)
+}
+
+func (z zip3Seq[X, Y, Z]) Skip(n int) Seq[Triple[X, Y, Z]] {
+	return zip3Seq[X, Y, Z]{
+		sx: z.sx.Skip(n),
+		sy: z.sy.Skip(n),
+		sz: z.sz.Skip(n),
+	}
+}
+
+func (z zip3Seq[X, Y, Z]) Where(pred Predicate[Triple[X, Y, Z]]) Seq[Triple[X, Y, Z]] {
+	return whereSeq[Triple[X, Y, Z]]{
+		seq:  z,
+		pred: pred,
+	}
+}
+
+func (z zip3Seq[X, Y, Z]) While(pred Predicate[Triple[X, Y, Z]]) Seq[Triple[X, Y, Z]] {
+	return whileSeq[Triple[X, Y, Z]]{
+		seq:  z,
+		pred: pred,
+	}
+}
+
+func (z zip3Seq[X, Y, Z]) First() (opt.Opt[Triple[X, Y, Z]], Seq[Triple[X, Y, Z]]) {
+	fx, tx := z.sx.First()
+	fy, ty := z.sy.First()
+	fz, tz := z.sz.First()
+	if err := zipError(fx.Error(), fy.Error(), fz.Error()); err != nil {
+		return opt.ErrorOf[Triple[X, Y, Z]](err), ErrorOf[Triple[X, Y, Z]](err)
+	}
+
+	return opt.Of(Triple[X, Y, Z]{fx.Must(), fy.Must(), fz.Must()}), zip3Seq[X, Y, Z]{tx, ty, tz}
+}
+
+func (z zip3Seq[X, Y, Z]) Map(funcMap FuncMap[Triple[X, Y, Z], Triple[X, Y, Z]]) Seq[Triple[X, Y, Z]] {
+	return mappedSeq[Triple[X, Y, Z], Triple[X, Y, Z]]{
+		f:   funcMap,
+		seq: z,
+	}
+}
+
+type zip4Seq[X, Y, Z, W any] struct {
+	sx Seq[X]
+	sy Seq[Y]
+	sz Seq[Z]
+	sw Seq[W]
+}
+
+// Zip4 creates a Seq that merges four Seqs into a series of Quad.
+// Like ZipOf, the zip Seq will stop at the shortest of the Seqs.
+// Unlike ZipOf, none of the element types are required to be comparable.
+func Zip4[X, Y, Z, W any](sx Seq[X], sy Seq[Y], sz Seq[Z], sw Seq[W]) Seq[Quad[X, Y, Z, W]] {
+	return zip4Seq[X, Y, Z, W]{
+		sx: sx,
+		sy: sy,
+		sz: sz,
+		sw: sw,
+	}
+}
+
+func (z zip4Seq[X, Y, Z, W]) ForEach(f Func1[Quad[X, Y, Z, W]]) opt.Opt[Quad[X, Y, Z, W]] {
+	var (
+		fst  opt.Opt[Quad[X, Y, Z, W]]
+		tail Seq[Quad[X, Y, Z, W]]
+	)
+	for fst, tail = z.First(); fst.Ok(); fst, tail = tail.First() {
+		f(fst.Must())
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (z zip4Seq[X, Y, Z, W]) ForEachIndex(f Func2[int, Quad[X, Y, Z, W]]) opt.Opt[Quad[X, Y, Z, W]] {
+	i := 0
+	return z.ForEach(func(q Quad[X, Y, Z, W]) {
+		f(i, q)
+		i++
+	})
+}
+
+func (z zip4Seq[X, Y, Z, W]) Len() (int, bool) {
+	sz, ok := z.sx.Len()
+	sz, ok = zipLen(sz, ok, z.sy)
+	sz, ok = zipLen(sz, ok, z.sz)
+	return zipLen(sz, ok, z.sw)
+}
+
+func (z zip4Seq[X, Y, Z, W]) ToSlice() Slice[Quad[X, Y, Z, W]] {
+	var arr []Quad[X, Y, Z, W]
+	if sz, ok := z.Len(); ok {
+		arr = make([]Quad[X, Y, Z, W], 0, sz)
+	}
+	z.ForEach(func(q Quad[X, Y, Z, W]) {
+		arr = append(arr, q)
+	})
+	return arr
+}
+
+func (z zip4Seq[X, Y, Z, W]) Limit(n int) Seq[Quad[X, Y, Z, W]] {
+	return LimitOf[Quad[X, Y, Z, W]](z, n)
+}
+
+func (z zip4Seq[X, Y, Z, W]) Take(n int) (Slice[Quad[X, Y, Z, W]], Seq[Quad[X, Y, Z, W]]) {
+	return takeFirst[Quad[X, Y, Z, W]](z, n)
+}
+
+func (z zip4Seq[X, Y, Z, W]) TakeWhile(pred Predicate[Quad[X, Y, Z, W]]) (Slice[Quad[X, Y, Z, W]], Seq[Quad[X, Y, Z, W]]) {
+	return takeWhileFirst[Quad[X, Y, Z, W]](z, pred)
+}
+
+func (z zip4Seq[X, Y, Z, W]) Skip(n int) Seq[Quad[X, Y, Z, W]] {
+	return zip4Seq[X, Y, Z, W]{
+		sx: z.sx.Skip(n),
+		sy: z.sy.Skip(n),
+		sz: z.sz.Skip(n),
+		sw: z.sw.Skip(n),
+	}
+}
+
+func (z zip4Seq[X, Y, Z, W]) Where(pred Predicate[Quad[X, Y, Z, W]]) Seq[Quad[X, Y, Z, W]] {
+	return whereSeq[Quad[X, Y, Z, W]]{
+		seq:  z,
+		pred: pred,
+	}
+}
+
+func (z zip4Seq[X, Y, Z, W]) While(pred Predicate[Quad[X, Y, Z, W]]) Seq[Quad[X, Y, Z, W]] {
+	return whileSeq[Quad[X, Y, Z, W]]{
+		seq:  z,
+		pred: pred,
+	}
+}
+
+func (z zip4Seq[X, Y, Z, W]) First() (opt.Opt[Quad[X, Y, Z, W]], Seq[Quad[X, Y, Z, W]]) {
+	fx, tx := z.sx.First()
+	fy, ty := z.sy.First()
+	fz, tz := z.sz.First()
+	fw, tw := z.sw.First()
+	if err := zipError(fx.Error(), fy.Error(), fz.Error(), fw.Error()); err != nil {
+		return opt.ErrorOf[Quad[X, Y, Z, W]](err), ErrorOf[Quad[X, Y, Z, W]](err)
+	}
+
+	return opt.Of(Quad[X, Y, Z, W]{fx.Must(), fy.Must(), fz.Must(), fw.Must()}), zip4Seq[X, Y, Z, W]{tx, ty, tz, tw}
+}
+
+func (z zip4Seq[X, Y, Z, W]) Map(funcMap FuncMap[Quad[X, Y, Z, W], Quad[X, Y, Z, W]]) Seq[Quad[X, Y, Z, W]] {
+	return mappedSeq[Quad[X, Y, Z, W], Quad[X, Y, Z, W]]{
+		f:   funcMap,
+		seq: z,
+	}
+}
+
+type zipLongestSeq[X, Y any] struct {
+	sx Seq[X]
+	sy Seq[Y]
+}
+
+// ZipLongest creates a Seq that merges two Seqs into a series of Joined.
+// Unlike ZipOf, the zip Seq continues until both Seqs are exhausted.
+// When one Seq is exhausted before the other, its side of the Joined is an empty opt.
+// You can think of it as a FullOuterJoin on the index of the elements.
+//
+// If either Seq is infinite, so is the zip Seq.
+func ZipLongest[X, Y any](sx Seq[X], sy Seq[Y]) Seq[Joined[X, Y]] {
+	return zipLongestSeq[X, Y]{
+		sx: sx,
+		sy: sy,
+	}
+}
+
+func (z zipLongestSeq[X, Y]) ForEach(f Func1[Joined[X, Y]]) opt.Opt[Joined[X, Y]] {
+	var (
+		fst  opt.Opt[Joined[X, Y]]
+		tail Seq[Joined[X, Y]]
+	)
+	for fst, tail = z.First(); fst.Ok(); fst, tail = tail.First() {
+		f(fst.Must())
+	}
+	return zeroIfEmpty(fst)
+}
+
+func (z zipLongestSeq[X, Y]) ForEachIndex(f Func2[int, Joined[X, Y]]) opt.Opt[Joined[X, Y]] {
+	i := 0
+	return z.ForEach(func(j Joined[X, Y]) {
+		f(i, j)
+		i++
+	})
+}
+
+func (z zipLongestSeq[X, Y]) Len() (int, bool) {
+	lx, okx := z.sx.Len()
+	ly, oky := z.sy.Len()
+	if okx && oky {
+		// Both well-defined. Return the maximum
+		if lx > ly {
+			return lx, true
+		}
+		return ly, true
+	}
+
+	if lx == LenInfinite || ly == LenInfinite {
+		return LenInfinite, false
+	}
+
+	return LenUnknown, false
+}
+
+func (z zipLongestSeq[X, Y]) ToSlice() Slice[Joined[X, Y]] {
+	var arr []Joined[X, Y]
+	if sz, ok := z.Len(); ok {
+		arr = make([]Joined[X, Y], 0, sz)
+	}
+	z.ForEach(func(j Joined[X, Y]) {
+		arr = append(arr, j)
+	})
+	return arr
+}
+
+func (z zipLongestSeq[X, Y]) Limit(n int) Seq[Joined[X, Y]] {
+	return LimitOf[Joined[X, Y]](z, n)
+}
+
+func (z zipLongestSeq[X, Y]) Take(n int) (Slice[Joined[X, Y]], Seq[Joined[X, Y]]) {
+	return takeFirst[Joined[X, Y]](z, n)
+}
+
+func (z zipLongestSeq[X, Y]) TakeWhile(pred Predicate[Joined[X, Y]]) (Slice[Joined[X, Y]], Seq[Joined[X, Y]]) {
+	return takeWhileFirst[Joined[X, Y]](z, pred)
+}
+
+func (z zipLongestSeq[X, Y]) Skip(n int) Seq[Joined[X, Y]] {
+	return zipLongestSeq[X, Y]{
+		sx: z.sx.Skip(n),
+		sy: z.sy.Skip(n),
+	}
+}
+
+func (z zipLongestSeq[X, Y]) Where(pred Predicate[Joined[X, Y]]) Seq[Joined[X, Y]] {
+	return whereSeq[Joined[X, Y]]{
+		seq:  z,
+		pred: pred,
+	}
+}
+
+func (z zipLongestSeq[X, Y]) While(pred Predicate[Joined[X, Y]]) Seq[Joined[X, Y]] {
+	return whileSeq[Joined[X, Y]]{
+		seq:  z,
+		pred: pred,
+	}
+}
+
+func (z zipLongestSeq[X, Y]) First() (opt.Opt[Joined[X, Y]], Seq[Joined[X, Y]]) {
+	fx, tx := z.sx.First()
+	fy, ty := z.sy.First()
+	if err := zipError(fx.Error(), fy.Error()); err != nil {
+		if err != opt.ErrEmpty || (fx.Empty() && fy.Empty()) {
+			return opt.ErrorOf[Joined[X, Y]](err), ErrorOf[Joined[X, Y]](err)
+		}
+	}
+
+	// At most one side is exhausted, and it is then an empty opt
+	return opt.Of(Joined[X, Y]{fx, fy}), zipLongestSeq[X, Y]{tx, ty}
+}
+
+func (z zipLongestSeq[X, Y]) Map(funcMap FuncMap[Joined[X, Y], Joined[X, Y]]) Seq[Joined[X, Y]] {
+	return mappedSeq[Joined[X, Y], Joined[X, Y]]{
+		f:   funcMap,
+		seq: z,
+	}
+}
+
+// unzipBuffer holds the elements that have been read from the source seq by one side of
+// an unzip, but not yet by the other.
+type unzipBuffer[X, Y any] struct {
+	mu  sync.Mutex
+	src Seq[Pair[X, Y]]
+	xs  []X
+	ys  []Y
+}
+
+// UnzipOf splits a seq of tuples into a seq of the keys and a seq of the values.
+// Both seqs read from the same execution of the input seq. The elements read by one seq
+// are buffered until they are read by the other, so the buffer grows with how far apart
+// the two seqs are executed.
+//
+// The returned seqs are stateful, like seqs created from a channel, but they are safe to
+// execute from different goroutines. If the input seq fails, both seqs return the error.
+//
+// Example:
+//
+//	names, ages := seq.UnzipOf(seq.ZipOf(names, ages))
+func UnzipOf[X comparable, Y any](seq Seq[Tuple[X, Y]]) (Seq[X], Seq[Y]) {
+	return UnzipPairOf(MappingOf(seq, Tuple[X, Y].Pair))
+}
+
+// UnzipPairOf is like UnzipOf, but splits a seq of Pair.
+func UnzipPairOf[X, Y any](seq Seq[Pair[X, Y]]) (Seq[X], Seq[Y]) {
+	buf := &unzipBuffer[X, Y]{src: seq}
+	return unfoldOf(buf, (*unzipBuffer[X, Y]).nextX), unfoldOf(buf, (*unzipBuffer[X, Y]).nextY)
+}
+
+func (b *unzipBuffer[X, Y]) nextX() (opt.Opt[X], *unzipBuffer[X, Y]) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.xs) > 0 {
+		x := b.xs[0]
+		b.xs = b.xs[1:]
+		return opt.Of(x), b
+	}
+
+	tup, err := b.read()
+	if err != nil {
+		return opt.ErrorOf[X](err), b
+	}
+	b.ys = append(b.ys, tup.y)
+	return opt.Of(tup.x), b
+}
+
+func (b *unzipBuffer[X, Y]) nextY() (opt.Opt[Y], *unzipBuffer[X, Y]) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.ys) > 0 {
+		y := b.ys[0]
+		b.ys = b.ys[1:]
+		return opt.Of(y), b
+	}
+
+	tup, err := b.read()
+	if err != nil {
+		return opt.ErrorOf[Y](err), b
+	}
+	b.xs = append(b.xs, tup.x)
+	return opt.Of(tup.y), b
+}
+
+// read reads the next tuple from the source seq. The caller must hold the lock.
+func (b *unzipBuffer[X, Y]) read() (Pair[X, Y], error) {
+	fst, tail := b.src.First()
+	b.src = tail
+	return fst.Return()
+}
+
+// zipLen combines the length of a zip with the length of another seq being zipped.
+func zipLen[T any](sz int, ok bool, seq Seq[T]) (int, bool) {
+	other, otherOk := seq.Len()
+	if ok && otherOk {
+		return min(sz, other), true
+	}
+
+	// If one is infinite, return the other.
+	if sz == LenInfinite {
+		return other, otherOk
+	} else if other == LenInfinite {
+		return sz, ok
+	}
+
+	return LenUnknown, false
+}
+
+// zipError returns the first error that is not opt.ErrEmpty, or opt.ErrEmpty if one of the
+// seqs are exhausted. If there are no errors it returns nil.
+func zipError(errs ...error) error {
+	var res error
+	for _, err := range errs {
+		if err == nil {
+			continue
+		} else if err != opt.ErrEmpty {
+			return err
+		}
+		res = err
+	}
+	return res
+}